@@ -0,0 +1,196 @@
+package manageiq
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Actionable is implemented by the resources advertising the actions which can be invoked on them.
+type Actionable interface {
+	GetActions() []Action
+}
+
+var (
+	_ Actionable = &MangeIQListResource{}
+	_ Actionable = &Group{}
+	_ Actionable = &Service{}
+	_ Actionable = &ProvisionRequest{}
+	_ Actionable = &AutomationRequest{}
+	_ Actionable = &Request{}
+)
+
+func (m *MangeIQListResource) GetActions() []Action {
+	return m.Actions
+}
+
+func (g *Group) GetActions() []Action {
+	return g.Actions
+}
+
+func (p *ProvisionRequest) GetActions() []Action {
+	return p.Actions
+}
+
+func (a *AutomationRequest) GetActions() []Action {
+	return a.Actions
+}
+
+func (r *Request) GetActions() []Action {
+	return r.Actions
+}
+
+// ActionResult is the response returned by ManageIQ for an action invoked on a resource.
+type ActionResult struct {
+	Success  bool   `json:"success"`
+	Message  string `json:"message"`
+	Href     string `json:"href"`
+	TaskID   string `json:"task_id"`
+	TaskHref string `json:"task_href"`
+}
+
+type actionResults struct {
+	Results []ActionResult `json:"results"`
+}
+
+type actionBody struct {
+	Action   string      `json:"action"`
+	Resource interface{} `json:"resource,omitempty"`
+}
+
+// FindAction returns the action advertised with the given name, or nil if the action isn't offered.
+func FindAction(actions []Action, name string) *Action {
+	for i := range actions {
+		if actions[i].Name == name {
+			return &actions[i]
+		}
+	}
+	return nil
+}
+
+// InvokeAction invokes the action advertised by the resource, data is sent as the resource of the action.
+func (c *Client) InvokeAction(ctx context.Context, resource Actionable, actionName string, data interface{}) (*ActionResult, error) {
+	action := FindAction(resource.GetActions(), actionName)
+	if action == nil {
+		return nil, fmt.Errorf("action '%s' is not offered by the resource", actionName)
+	}
+	return c.invokeAction(ctx, *action, data)
+}
+
+func (c *Client) invokeAction(ctx context.Context, action Action, data interface{}) (*ActionResult, error) {
+	if action.Href == "" {
+		return nil, fmt.Errorf("action '%s' has no href", action.Name)
+	}
+	method := strings.ToUpper(action.Method)
+	if method == "" {
+		method = POST
+	}
+	return c.doAction(ctx, method, action.Href, "", action.Name, data)
+}
+
+func (c *Client) doAction(ctx context.Context, method, baseURL, path, name string, data interface{}) (*ActionResult, error) {
+	return c.doActionInto(ctx, method, baseURL, path, name, data, nil)
+}
+
+// doActionInto invokes the action like doAction and additionally decodes the response into result
+// when it isn't nil, this is used by the actions returning more than the common action result.
+func (c *Client) doActionInto(ctx context.Context, method, baseURL, path, name string, data, result interface{}) (*ActionResult, error) {
+	builder := NewRequestBuilder(method).WithContext(ctx)
+	_, err := builder.ResolveRequestURL(baseURL, path, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if method != GET && method != DELETE {
+		if _, err := builder.SetBodyContentJSON(&actionBody{Action: name, Resource: data}); err != nil {
+			return nil, err
+		}
+	}
+
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	r := &ActionResult{Success: true}
+	if len(resp.RawResult) == 0 {
+		return r, nil
+	}
+	if err := json.Unmarshal(resp.RawResult, &r); err != nil {
+		return nil, err
+	}
+	if !r.Success {
+		return nil, fmt.Errorf("action '%s' failed: %s", name, r.Message)
+	}
+	if result != nil {
+		if err := json.Unmarshal(resp.RawResult, result); err != nil {
+			return nil, err
+		}
+	}
+	return r, nil
+}
+
+// doCollectionAction invokes the action on the collection at path, this is used by the collections where
+// actions like create are processed as tasks.
+func (c *Client) doCollectionAction(ctx context.Context, path, name string, data interface{}) (*ActionResult, error) {
+	builder := NewRequestBuilder(POST).WithContext(ctx)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), path, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := builder.SetBodyContentJSON(&actionBody{Action: name, Resource: data}); err != nil {
+		return nil, err
+	}
+
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	r := &actionResults{}
+	if err := json.Unmarshal(resp.RawResult, &r); err != nil {
+		return nil, err
+	}
+	if len(r.Results) == 0 {
+		return nil, fmt.Errorf("no result returned for the action '%s'", name)
+	}
+	if !r.Results[0].Success {
+		return nil, fmt.Errorf("action '%s' failed: %s", name, r.Results[0].Message)
+	}
+	return &r.Results[0], nil
+}
+
+// ListActions returns the actions advertised by the resource at the given href.
+func (c *Client) ListActions(ctx context.Context, href string) ([]Action, error) {
+	builder := NewRequestBuilder(GET).WithContext(ctx)
+	_, err := builder.ResolveRequestURL(href, "", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	r := &struct {
+		Actions []Action `json:"actions"`
+	}{}
+	if err := json.Unmarshal(resp.RawResult, &r); err != nil {
+		return nil, err
+	}
+	return r.Actions, nil
+}
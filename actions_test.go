@@ -0,0 +1,154 @@
+package manageiq
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// newTestClient returns a client talking to a server replying with the given status and body, the
+// body of the last request received is stored in requestBody.
+func newTestClient(t *testing.T, status int, body string, requestBody *string) *Client {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if requestBody != nil {
+			b, _ := ioutil.ReadAll(r.Body)
+			*requestBody = string(b)
+		}
+		w.WriteHeader(status)
+		w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+	return &Client{
+		Authenticator: &BearerAuthenticator{Token: "token", BaseURL: server.URL},
+		HTTPClient:    server.Client(),
+	}
+}
+
+func TestDoAction(t *testing.T) {
+	tests := []struct {
+		name    string
+		method  string
+		status  int
+		body    string
+		want    ActionResult
+		wantErr string
+	}{
+		{
+			name:   "success",
+			method: POST,
+			status: http.StatusOK,
+			body:   `{"success": true, "message": "started", "task_id": "12", "task_href": "/api/tasks/12"}`,
+			want:   ActionResult{Success: true, Message: "started", TaskID: "12", TaskHref: "/api/tasks/12"},
+		},
+		{
+			name:    "failure reported in the body",
+			method:  POST,
+			status:  http.StatusOK,
+			body:    `{"success": false, "message": "service is retired"}`,
+			wantErr: "action 'start' failed: service is retired",
+		},
+		{
+			name:   "resource returned",
+			method: POST,
+			status: http.StatusOK,
+			body:   `{"href": "/api/services/1", "id": "1"}`,
+			want:   ActionResult{Success: true, Href: "/api/services/1"},
+		},
+		{
+			name:   "empty body",
+			method: DELETE,
+			status: http.StatusNoContent,
+			want:   ActionResult{Success: true},
+		},
+		{
+			name:    "error status",
+			method:  POST,
+			status:  http.StatusBadRequest,
+			body:    `{"code": 400, "message": "invalid action"}`,
+			wantErr: "invalid action",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newTestClient(t, tt.status, tt.body, nil)
+			got, err := c.doAction(context.Background(), tt.method, c.Authenticator.GetBaseURL(), "/services/1", "start", nil)
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Fatalf("expected error %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if *got != tt.want {
+				t.Errorf("expected %+v, got %+v", tt.want, *got)
+			}
+		})
+	}
+}
+
+func TestDoActionBody(t *testing.T) {
+	var body string
+	c := newTestClient(t, http.StatusOK, `{"success": true}`, &body)
+	if _, err := c.doAction(context.Background(), POST, c.Authenticator.GetBaseURL(), "/services/1", "add_resource", map[string]string{"href": "/api/vms/2"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := `{"action":"add_resource","resource":{"href":"/api/vms/2"}}`; strings.TrimSpace(body) != want {
+		t.Errorf("expected body %s, got %s", want, body)
+	}
+
+	if _, err := c.doAction(context.Background(), GET, c.Authenticator.GetBaseURL(), "/services/1", "", nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if body != "" {
+		t.Errorf("expected no body for GET, got %s", body)
+	}
+}
+
+func TestDoCollectionAction(t *testing.T) {
+	tests := []struct {
+		name    string
+		body    string
+		want    ActionResult
+		wantErr string
+	}{
+		{
+			name: "success",
+			body: `{"results": [{"success": true, "message": "creating", "task_id": "3"}]}`,
+			want: ActionResult{Success: true, Message: "creating", TaskID: "3"},
+		},
+		{
+			name:    "failure",
+			body:    `{"results": [{"success": false, "message": "name is taken"}]}`,
+			wantErr: "action 'create' failed: name is taken",
+		},
+		{
+			name:    "no result",
+			body:    `{"results": []}`,
+			wantErr: "no result returned for the action 'create'",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newTestClient(t, http.StatusOK, tt.body, nil)
+			got, err := c.doCollectionAction(context.Background(), "/authentications", "create", nil)
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Fatalf("expected error %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if *got != tt.want {
+				t.Errorf("expected %+v, got %+v", tt.want, *got)
+			}
+		})
+	}
+}
@@ -0,0 +1,540 @@
+package manageiq
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+const (
+	AlertSeverityInfo    = "info"
+	AlertSeverityWarning = "warning"
+	AlertSeverityError   = "error"
+)
+
+type AlertDefinitions struct {
+	MangeIQListResource
+	Resources []AlertDefinition `json:"resources"`
+}
+
+type AlertDefinition struct {
+	Href        string                 `json:"href,omitempty"`
+	ID          string                 `json:"id,omitempty"`
+	GUID        string                 `json:"guid,omitempty"`
+	Description string                 `json:"description,omitempty"`
+	DB          string                 `json:"db,omitempty"`
+	Severity    string                 `json:"severity,omitempty"`
+	Enabled     *bool                  `json:"enabled,omitempty"`
+	ReadOnly    bool                   `json:"read_only,omitempty"`
+	Expression  map[string]interface{} `json:"expression,omitempty"`
+	Options     map[string]interface{} `json:"options,omitempty"`
+	// Evaluation frequency in seconds
+	Frequency int       `json:"frequency,omitempty"`
+	CreatedOn time.Time `json:"created_on"`
+	UpdatedOn time.Time `json:"updated_on"`
+	Actions   []Action  `json:"actions,omitempty"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (a *AlertDefinition) UnmarshalJSON(b []byte) error {
+	type alias AlertDefinition
+	extra, err := unmarshalWithExtra(b, (*alias)(a))
+	if err != nil {
+		return err
+	}
+	a.Extra = extra
+	return nil
+}
+
+func (a AlertDefinition) MarshalJSON() ([]byte, error) {
+	type alias AlertDefinition
+	return marshalWithExtra(alias(a), a.Extra)
+}
+
+type AlertDefinitionProfiles struct {
+	MangeIQListResource
+	Resources []AlertDefinitionProfile `json:"resources"`
+}
+
+type AlertDefinitionProfile struct {
+	Href        string    `json:"href,omitempty"`
+	ID          string    `json:"id,omitempty"`
+	GUID        string    `json:"guid,omitempty"`
+	Name        string    `json:"name,omitempty"`
+	Description string    `json:"description,omitempty"`
+	Mode        string    `json:"mode,omitempty"`
+	SetType     string    `json:"set_type,omitempty"`
+	ReadOnly    bool      `json:"read_only,omitempty"`
+	CreatedOn   time.Time `json:"created_on"`
+	UpdatedOn   time.Time `json:"updated_on"`
+	Actions     []Action  `json:"actions,omitempty"`
+	// Only used when creating or editing the profile
+	AlertDefinitions []ResourceRef `json:"alert_definitions,omitempty"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (a *AlertDefinitionProfile) UnmarshalJSON(b []byte) error {
+	type alias AlertDefinitionProfile
+	extra, err := unmarshalWithExtra(b, (*alias)(a))
+	if err != nil {
+		return err
+	}
+	a.Extra = extra
+	return nil
+}
+
+func (a AlertDefinitionProfile) MarshalJSON() ([]byte, error) {
+	type alias AlertDefinitionProfile
+	return marshalWithExtra(alias(a), a.Extra)
+}
+
+type Alerts struct {
+	MangeIQListResource
+	Resources []Alert `json:"resources"`
+}
+
+// Alert is an alert raised by an alert definition, the state of the alert is tracked by its alert actions.
+type Alert struct {
+	Href         string    `json:"href"`
+	ID           string    `json:"id"`
+	Description  string    `json:"description"`
+	Severity     string    `json:"severity"`
+	Result       bool      `json:"result"`
+	URL          string    `json:"url"`
+	EvaluatedOn  time.Time `json:"evaluated_on"`
+	ResourceID   string    `json:"resource_id"`
+	ResourceType string    `json:"resource_type"`
+	MiqAlertID   string    `json:"miq_alert_id"`
+	EMSID        string    `json:"ems_id"`
+	Acknowledged bool      `json:"acknowledged"`
+	AssigneeID   string    `json:"assignee_id"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+	Actions      []Action  `json:"actions"`
+	// Only populated when requested with attributes=alert_actions
+	AlertActions []AlertAction `json:"alert_actions"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (a *Alert) UnmarshalJSON(b []byte) error {
+	type alias Alert
+	extra, err := unmarshalWithExtra(b, (*alias)(a))
+	if err != nil {
+		return err
+	}
+	a.Extra = extra
+	return nil
+}
+
+func (a Alert) MarshalJSON() ([]byte, error) {
+	type alias Alert
+	return marshalWithExtra(alias(a), a.Extra)
+}
+
+type AlertAction struct {
+	Href       string    `json:"href,omitempty"`
+	ID         string    `json:"id,omitempty"`
+	ActionType string    `json:"action_type,omitempty"`
+	Comment    string    `json:"comment,omitempty"`
+	UserID     string    `json:"user_id,omitempty"`
+	AssigneeID string    `json:"assignee_id,omitempty"`
+	AlertID    string    `json:"miq_alert_status_id,omitempty"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+	// Only set when assigning the alert, the assignee of an alert is returned as AssigneeID
+	Assignee *AlertAssignee `json:"assignee,omitempty"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (a *AlertAction) UnmarshalJSON(b []byte) error {
+	type alias AlertAction
+	extra, err := unmarshalWithExtra(b, (*alias)(a))
+	if err != nil {
+		return err
+	}
+	a.Extra = extra
+	return nil
+}
+
+func (a AlertAction) MarshalJSON() ([]byte, error) {
+	type alias AlertAction
+	return marshalWithExtra(alias(a), a.Extra)
+}
+
+// AlertAssignee references the user assigned to an alert by id or href.
+type AlertAssignee struct {
+	ID   string `json:"id,omitempty"`
+	Href string `json:"href,omitempty"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (a *AlertAssignee) UnmarshalJSON(b []byte) error {
+	type alias AlertAssignee
+	extra, err := unmarshalWithExtra(b, (*alias)(a))
+	if err != nil {
+		return err
+	}
+	a.Extra = extra
+	return nil
+}
+
+func (a AlertAssignee) MarshalJSON() ([]byte, error) {
+	type alias AlertAssignee
+	return marshalWithExtra(alias(a), a.Extra)
+}
+
+type alertActionResults struct {
+	Results []AlertAction `json:"results"`
+}
+
+// AlertFilter narrows the alerts returned by ListAlerts.
+type AlertFilter struct {
+	Severity     string
+	Acknowledged *bool
+	// Only return the alerts raised for the resource type, e.g. Vm or Host
+	ResourceType string
+	ResourceID   string
+}
+
+// queries returns the queries merged with the filter[] entries of the filter.
+func (f *AlertFilter) queries(queries url.Values) url.Values {
+	queries = withList(queries, "expand", "resources")
+	if f == nil {
+		return queries
+	}
+	if f.Severity != "" {
+		queries.Add("filter[]", "severity="+f.Severity)
+	}
+	if f.Acknowledged != nil {
+		queries.Add("filter[]", fmt.Sprintf("acknowledged=%t", *f.Acknowledged))
+	}
+	if f.ResourceType != "" {
+		queries.Add("filter[]", "resource_type="+f.ResourceType)
+	}
+	if f.ResourceID != "" {
+		queries.Add("filter[]", "resource_id="+f.ResourceID)
+	}
+	return queries
+}
+
+type alertDefinitionResults struct {
+	Results []AlertDefinition `json:"results"`
+}
+
+type alertDefinitionProfileResults struct {
+	Results []AlertDefinitionProfile `json:"results"`
+}
+
+func (c *Client) ListAlertDefinitions(queries url.Values) (*AlertDefinitions, error) {
+	builder := NewRequestBuilder(GET)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/alert_definitions", nil, queries)
+	if err != nil {
+		return nil, err
+	}
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	a := &AlertDefinitions{}
+	if err := json.Unmarshal(resp.RawResult, &a); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+func (c *Client) GetAlertDefinition(id string, queries url.Values) (*AlertDefinition, error) {
+	builder := NewRequestBuilder(GET)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/alert_definitions/"+id, nil, queries)
+	if err != nil {
+		return nil, err
+	}
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	a := &AlertDefinition{}
+	if err := json.Unmarshal(resp.RawResult, &a); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+func (c *Client) CreateAlertDefinition(ctx context.Context, a *AlertDefinition) (*AlertDefinition, error) {
+	builder := NewRequestBuilder(POST).WithContext(ctx)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/alert_definitions", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := builder.SetBodyContentJSON(&actionBody{Action: "create", Resource: a}); err != nil {
+		return nil, err
+	}
+
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	r := &alertDefinitionResults{}
+	if err := json.Unmarshal(resp.RawResult, &r); err != nil {
+		return nil, err
+	}
+	if len(r.Results) == 0 {
+		return nil, fmt.Errorf("no alert definition returned in the response")
+	}
+	return &r.Results[0], nil
+}
+
+func (c *Client) UpdateAlertDefinition(ctx context.Context, id string, a *AlertDefinition) (*AlertDefinition, error) {
+	builder := NewRequestBuilder(POST).WithContext(ctx)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/alert_definitions/"+id, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := builder.SetBodyContentJSON(&actionBody{Action: "edit", Resource: a}); err != nil {
+		return nil, err
+	}
+
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	u := &AlertDefinition{}
+	if err := json.Unmarshal(resp.RawResult, &u); err != nil {
+		return nil, err
+	}
+	return u, nil
+}
+
+func (c *Client) DeleteAlertDefinition(ctx context.Context, id string) (*ActionResult, error) {
+	return c.doAction(ctx, POST, c.Authenticator.GetBaseURL(), "/alert_definitions/"+id, "delete", nil)
+}
+
+func (c *Client) ListAlertDefinitionProfiles(queries url.Values) (*AlertDefinitionProfiles, error) {
+	builder := NewRequestBuilder(GET)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/alert_definition_profiles", nil, queries)
+	if err != nil {
+		return nil, err
+	}
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	p := &AlertDefinitionProfiles{}
+	if err := json.Unmarshal(resp.RawResult, &p); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+func (c *Client) GetAlertDefinitionProfile(id string, queries url.Values) (*AlertDefinitionProfile, error) {
+	builder := NewRequestBuilder(GET)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/alert_definition_profiles/"+id, nil, queries)
+	if err != nil {
+		return nil, err
+	}
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	p := &AlertDefinitionProfile{}
+	if err := json.Unmarshal(resp.RawResult, &p); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+func (c *Client) CreateAlertDefinitionProfile(ctx context.Context, p *AlertDefinitionProfile) (*AlertDefinitionProfile, error) {
+	builder := NewRequestBuilder(POST).WithContext(ctx)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/alert_definition_profiles", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := builder.SetBodyContentJSON(&actionBody{Action: "create", Resource: p}); err != nil {
+		return nil, err
+	}
+
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	r := &alertDefinitionProfileResults{}
+	if err := json.Unmarshal(resp.RawResult, &r); err != nil {
+		return nil, err
+	}
+	if len(r.Results) == 0 {
+		return nil, fmt.Errorf("no alert definition profile returned in the response")
+	}
+	return &r.Results[0], nil
+}
+
+func (c *Client) UpdateAlertDefinitionProfile(ctx context.Context, id string, p *AlertDefinitionProfile) (*AlertDefinitionProfile, error) {
+	builder := NewRequestBuilder(POST).WithContext(ctx)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/alert_definition_profiles/"+id, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := builder.SetBodyContentJSON(&actionBody{Action: "edit", Resource: p}); err != nil {
+		return nil, err
+	}
+
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	u := &AlertDefinitionProfile{}
+	if err := json.Unmarshal(resp.RawResult, &u); err != nil {
+		return nil, err
+	}
+	return u, nil
+}
+
+func (c *Client) DeleteAlertDefinitionProfile(ctx context.Context, id string) (*ActionResult, error) {
+	return c.doAction(ctx, POST, c.Authenticator.GetBaseURL(), "/alert_definition_profiles/"+id, "delete", nil)
+}
+
+// ListAlerts returns the alerts matching the filter, the filter is merged into the queries.
+func (c *Client) ListAlerts(filter *AlertFilter, queries url.Values) (*Alerts, error) {
+	builder := NewRequestBuilder(GET)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/alerts", nil, filter.queries(queries))
+	if err != nil {
+		return nil, err
+	}
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	a := &Alerts{}
+	if err := json.Unmarshal(resp.RawResult, &a); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// GetAlert returns the alert along with its alert actions.
+func (c *Client) GetAlert(id string, queries url.Values) (*Alert, error) {
+	builder := NewRequestBuilder(GET)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/alerts/"+id, nil, withList(queries, "attributes", "alert_actions"))
+	if err != nil {
+		return nil, err
+	}
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	a := &Alert{}
+	if err := json.Unmarshal(resp.RawResult, &a); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+func (c *Client) createAlertAction(ctx context.Context, id string, action *AlertAction) (*AlertAction, error) {
+	builder := NewRequestBuilder(POST).WithContext(ctx)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/alerts/"+id+"/alert_actions", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := builder.SetBodyContentJSON(&actionBody{Action: "create", Resource: action}); err != nil {
+		return nil, err
+	}
+
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	r := &alertActionResults{}
+	if err := json.Unmarshal(resp.RawResult, &r); err != nil {
+		return nil, err
+	}
+	if len(r.Results) == 0 {
+		return nil, fmt.Errorf("no alert action returned in the response")
+	}
+	return &r.Results[0], nil
+}
+
+func (c *Client) AcknowledgeAlert(ctx context.Context, id, comment string) (*AlertAction, error) {
+	return c.createAlertAction(ctx, id, &AlertAction{ActionType: "acknowledge", Comment: comment})
+}
+
+func (c *Client) UnacknowledgeAlert(ctx context.Context, id, comment string) (*AlertAction, error) {
+	return c.createAlertAction(ctx, id, &AlertAction{ActionType: "unacknowledge", Comment: comment})
+}
+
+func (c *Client) CommentAlert(ctx context.Context, id, comment string) (*AlertAction, error) {
+	return c.createAlertAction(ctx, id, &AlertAction{ActionType: "comment", Comment: comment})
+}
+
+// AssignAlert assigns the alert to the user with the given id.
+func (c *Client) AssignAlert(ctx context.Context, id, assigneeID, comment string) (*AlertAction, error) {
+	return c.createAlertAction(ctx, id, &AlertAction{ActionType: "assign", Assignee: &AlertAssignee{ID: assigneeID}, Comment: comment})
+}
+
+func (c *Client) UnassignAlert(ctx context.Context, id, comment string) (*AlertAction, error) {
+	return c.createAlertAction(ctx, id, &AlertAction{ActionType: "unassign", Comment: comment})
+}
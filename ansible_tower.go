@@ -0,0 +1,238 @@
+package manageiq
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	JobStatusPending    = "pending"
+	JobStatusRunning    = "running"
+	JobStatusSuccessful = "successful"
+	JobStatusFailed     = "failed"
+	JobStatusError      = "error"
+	JobStatusCanceled   = "canceled"
+)
+
+type ConfigurationScripts struct {
+	MangeIQListResource
+	Resources []ConfigurationScript `json:"resources"`
+}
+
+// ConfigurationScript is a job template of an Ansible Tower / AWX provider.
+type ConfigurationScript struct {
+	Href                 string                 `json:"href"`
+	ID                   string                 `json:"id"`
+	Name                 string                 `json:"name"`
+	Description          string                 `json:"description"`
+	Type                 string                 `json:"type"`
+	ManagerID            string                 `json:"manager_id"`
+	ManagerRef           string                 `json:"manager_ref"`
+	InventoryRootGroupID string                 `json:"inventory_root_group_id"`
+	Variables            map[string]interface{} `json:"variables"`
+	SurveySpec           map[string]interface{} `json:"survey_spec"`
+	CreatedAt            time.Time              `json:"created_at"`
+	UpdatedAt            time.Time              `json:"updated_at"`
+	Actions              []Action               `json:"actions"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (c *ConfigurationScript) UnmarshalJSON(b []byte) error {
+	type alias ConfigurationScript
+	extra, err := unmarshalWithExtra(b, (*alias)(c))
+	if err != nil {
+		return err
+	}
+	c.Extra = extra
+	return nil
+}
+
+func (c ConfigurationScript) MarshalJSON() ([]byte, error) {
+	type alias ConfigurationScript
+	return marshalWithExtra(alias(c), c.Extra)
+}
+
+type launchBody struct {
+	ExtraVars map[string]interface{} `json:"extra_vars,omitempty"`
+}
+
+func (c *Client) ListConfigurationScripts(queries url.Values) (*ConfigurationScripts, error) {
+	builder := NewRequestBuilder(GET)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/configuration_scripts", nil, queries)
+	if err != nil {
+		return nil, err
+	}
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	s := &ConfigurationScripts{}
+	if err := json.Unmarshal(resp.RawResult, &s); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (c *Client) GetConfigurationScript(id string, queries url.Values) (*ConfigurationScript, error) {
+	builder := NewRequestBuilder(GET)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/configuration_scripts/"+id, nil, queries)
+	if err != nil {
+		return nil, err
+	}
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	s := &ConfigurationScript{}
+	if err := json.Unmarshal(resp.RawResult, &s); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// LaunchResult is the result of launching a job template, JobID is the id of the orchestration stack
+// tracking the job.
+type LaunchResult struct {
+	ActionResult
+	JobID string `json:"-"`
+}
+
+// LaunchConfigurationScript launches the job template with the extra vars and returns the id of the job
+// started. When the launch is processed by a task, the task is waited for and the job is read from its results.
+func (c *Client) LaunchConfigurationScript(ctx context.Context, id string, extraVars map[string]interface{}) (*LaunchResult, error) {
+	var order map[string]interface{}
+	r, err := c.doActionInto(ctx, POST, c.Authenticator.GetBaseURL(), "/configuration_scripts/"+id, "order", &launchBody{ExtraVars: extraVars}, &order)
+	if err != nil {
+		return nil, err
+	}
+	result := &LaunchResult{ActionResult: *r, JobID: jobID(order)}
+	if result.JobID != "" || r.TaskID == "" {
+		return result, nil
+	}
+
+	task, err := c.WaitForTask(ctx, r.TaskID, 0)
+	if err != nil {
+		return nil, err
+	}
+	result.JobID = jobID(task.Results)
+	if result.JobID == "" {
+		return nil, fmt.Errorf("no job returned by the task %s", r.TaskID)
+	}
+	return result, nil
+}
+
+// jobID returns the id of the orchestration stack referenced by v, v is either the href of the
+// stack or an object holding its id or href.
+func jobID(v interface{}) string {
+	switch v := v.(type) {
+	case string:
+		if i := strings.Index(v, "/orchestration_stacks/"); i >= 0 {
+			return strings.TrimSuffix(v[i+len("/orchestration_stacks/"):], "/")
+		}
+	case map[string]interface{}:
+		for _, key := range []string{"job_id", "stack_id", "orchestration_stack_id"} {
+			if id, ok := v[key]; ok && id != nil {
+				return fmt.Sprint(id)
+			}
+		}
+		for _, key := range []string{"href", "job_href", "stack_href"} {
+			if id := jobID(v[key]); id != "" {
+				return id
+			}
+		}
+		for _, key := range []string{"job", "stack", "orchestration_stack"} {
+			if id := jobID(v[key]); id != "" {
+				return id
+			}
+		}
+	}
+	return ""
+}
+
+// ListJobs returns the jobs launched from the job template, most recent first.
+func (c *Client) ListJobs(templateID string) (*OrchestrationStacks, error) {
+	queries := url.Values{
+		"expand":     []string{"resources"},
+		"filter[]":   []string{"configuration_script_id=" + templateID},
+		"sort_by":    []string{"id"},
+		"sort_order": []string{"desc"},
+	}
+	return c.ListOrchestrationStacks(queries)
+}
+
+func isJobFinished(status string) bool {
+	switch status {
+	case JobStatusSuccessful, JobStatusFailed, JobStatusError, JobStatusCanceled:
+		return true
+	}
+	return false
+}
+
+// WaitForJob polls the job every interval until it is finished and returns its final state,
+// an error is returned if the job didn't succeed. The default interval is used if interval isn't positive.
+func (c *Client) WaitForJob(ctx context.Context, id string, interval time.Duration) (*OrchestrationStack, error) {
+	if interval <= 0 {
+		interval = defaultWaitInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		job, err := c.getOrchestrationStack(ctx, id, nil)
+		if err != nil {
+			return nil, err
+		}
+		if isJobFinished(job.Status) {
+			if job.Status != JobStatusSuccessful {
+				return job, fmt.Errorf("job %s finished with status %s: %s", id, job.Status, job.StatusReason)
+			}
+			return job, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// GetJobStdout returns the standard output of the job.
+func (c *Client) GetJobStdout(id string) (string, error) {
+	queries := url.Values{"attributes": []string{"stdout"}}
+	builder := NewRequestBuilder(GET)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/orchestration_stacks/"+id, nil, queries)
+	if err != nil {
+		return "", err
+	}
+	req, err := builder.Build()
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return "", err
+	}
+	s := &struct {
+		Stdout string `json:"stdout"`
+	}{}
+	if err := json.Unmarshal(resp.RawResult, &s); err != nil {
+		return "", err
+	}
+	return s.Stdout, nil
+}
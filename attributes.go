@@ -0,0 +1,172 @@
+package manageiq
+
+import (
+	"encoding/json"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+const (
+	AttributeVMs              = "vms"
+	AttributeTags             = "tags"
+	AttributeCustomAttributes = "custom_attributes"
+	AttributePicture          = "picture"
+	AttributeParentService    = "parent_service"
+	AttributeChildServices    = "direct_service_children"
+)
+
+// QueryOption sets a query parameter of the requests listing or getting resources.
+type QueryOption func(url.Values)
+
+// Query returns the queries built from the options, to be passed to the list and get methods, e.g.
+//
+//	c.GetService(id, manageiq.Query(manageiq.WithAttributes(manageiq.AttributeVMs, manageiq.AttributeTags)))
+func Query(opts ...QueryOption) url.Values {
+	queries := url.Values{}
+	for _, opt := range opts {
+		opt(queries)
+	}
+	return queries
+}
+
+// WithAttributes requests the virtual attributes and relationships of the resources, the known ones are
+// decoded into the typed fields of the models and the others are kept in their Extra map.
+func WithAttributes(attributes ...string) QueryOption {
+	return func(queries url.Values) {
+		appendList(queries, "attributes", attributes)
+	}
+}
+
+// WithExpand expands the given collections, e.g. resources for listing the resources with all their attributes.
+func WithExpand(expand ...string) QueryOption {
+	return func(queries url.Values) {
+		appendList(queries, "expand", expand)
+	}
+}
+
+// WithFilter adds the filter expressions, e.g. name='foo'.
+func WithFilter(filters ...string) QueryOption {
+	return func(queries url.Values) {
+		for _, filter := range filters {
+			queries.Add("filter[]", filter)
+		}
+	}
+}
+
+func WithSortBy(field, order string) QueryOption {
+	return func(queries url.Values) {
+		queries.Set("sort_by", field)
+		if order != "" {
+			queries.Set("sort_order", order)
+		}
+	}
+}
+
+func WithLimit(limit int) QueryOption {
+	return func(queries url.Values) {
+		queries.Set("limit", strconv.Itoa(limit))
+	}
+}
+
+func WithOffset(offset int) QueryOption {
+	return func(queries url.Values) {
+		queries.Set("offset", strconv.Itoa(offset))
+	}
+}
+
+// withList returns a copy of the queries with the values appended to the comma separated list of
+// the query parameter, this is used by the methods always requesting some attributes or expansions.
+func withList(queries url.Values, key string, values ...string) url.Values {
+	merged := url.Values{}
+	for k, v := range queries {
+		merged[k] = append([]string(nil), v...)
+	}
+	appendList(merged, key, values)
+	return merged
+}
+
+// appendList appends the values to the comma separated list of the query parameter.
+func appendList(queries url.Values, key string, values []string) {
+	if len(values) == 0 {
+		return
+	}
+	if existing := queries.Get(key); existing != "" {
+		values = append([]string{existing}, values...)
+	}
+	queries.Set(key, strings.Join(values, ","))
+}
+
+type Tag struct {
+	Href string `json:"href,omitempty"`
+	ID   string `json:"id,omitempty"`
+	// Name of the tag in the form of /managed/<category>/<name>
+	Name string `json:"name,omitempty"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (t *Tag) UnmarshalJSON(b []byte) error {
+	type alias Tag
+	extra, err := unmarshalWithExtra(b, (*alias)(t))
+	if err != nil {
+		return err
+	}
+	t.Extra = extra
+	return nil
+}
+
+func (t Tag) MarshalJSON() ([]byte, error) {
+	type alias Tag
+	return marshalWithExtra(alias(t), t.Extra)
+}
+
+type Picture struct {
+	Href      string `json:"href"`
+	ID        string `json:"id"`
+	ImageHref string `json:"image_href"`
+	Extension string `json:"extension"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (p *Picture) UnmarshalJSON(b []byte) error {
+	type alias Picture
+	extra, err := unmarshalWithExtra(b, (*alias)(p))
+	if err != nil {
+		return err
+	}
+	p.Extra = extra
+	return nil
+}
+
+func (p Picture) MarshalJSON() ([]byte, error) {
+	type alias Picture
+	return marshalWithExtra(alias(p), p.Extra)
+}
+
+// CustomAttribute is a key/value pair attached to a resource, e.g. the labels of container resources.
+type CustomAttribute struct {
+	ID      string `json:"id"`
+	Name    string `json:"name"`
+	Value   string `json:"value"`
+	Section string `json:"section"`
+	Source  string `json:"source"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (c *CustomAttribute) UnmarshalJSON(b []byte) error {
+	type alias CustomAttribute
+	extra, err := unmarshalWithExtra(b, (*alias)(c))
+	if err != nil {
+		return err
+	}
+	c.Extra = extra
+	return nil
+}
+
+func (c CustomAttribute) MarshalJSON() ([]byte, error) {
+	type alias CustomAttribute
+	return marshalWithExtra(alias(c), c.Extra)
+}
@@ -17,9 +17,11 @@ import (
 const (
 	defaultBaseURL = "https://127.0.0.1:8443/api"
 
-	POST = "POST"
-	GET  = "GET"
-	PUT  = "PUT"
+	POST   = "POST"
+	GET    = "GET"
+	PUT    = "PUT"
+	PATCH  = "PATCH"
+	DELETE = "DELETE"
 
 	ERRORMSG_SERVICE_URL_MISSING = "service GetBaseURL is empty"
 	ERRORMSG_SERVICE_URL_INVALID = "error parsing service GetBaseURL: %s"
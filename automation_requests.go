@@ -0,0 +1,165 @@
+package manageiq
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+const defaultAutomationRequestVersion = "1.1"
+
+// AutomationURI identifies the Automate entry point to be invoked.
+type AutomationURI struct {
+	Namespace string `json:"namespace"`
+	Class     string `json:"class"`
+	Instance  string `json:"instance"`
+	Message   string `json:"message,omitempty"`
+}
+
+type AutomationRequests struct {
+	MangeIQListResource
+	Resources []AutomationRequest `json:"resources"`
+}
+
+type AutomationRequest struct {
+	Href          string                 `json:"href"`
+	ID            string                 `json:"id"`
+	Description   string                 `json:"description"`
+	Type          string                 `json:"type"`
+	RequestType   string                 `json:"request_type"`
+	ApprovalState string                 `json:"approval_state"`
+	RequestState  string                 `json:"request_state"`
+	Status        string                 `json:"status"`
+	Message       string                 `json:"message"`
+	UserID        string                 `json:"userid"`
+	CreatedOn     time.Time              `json:"created_on"`
+	UpdatedOn     time.Time              `json:"updated_on"`
+	FulfilledOn   time.Time              `json:"fulfilled_on"`
+	Options       map[string]interface{} `json:"options"`
+	Actions       []Action               `json:"actions"`
+	RequestTasks  []RequestTask          `json:"miq_request_tasks"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (a *AutomationRequest) UnmarshalJSON(b []byte) error {
+	type alias AutomationRequest
+	extra, err := unmarshalWithExtra(b, (*alias)(a))
+	if err != nil {
+		return err
+	}
+	a.Extra = extra
+	return nil
+}
+
+func (a AutomationRequest) MarshalJSON() ([]byte, error) {
+	type alias AutomationRequest
+	return marshalWithExtra(alias(a), a.Extra)
+}
+
+type automationRequestBody struct {
+	Version    string                 `json:"version"`
+	URIParts   AutomationURI          `json:"uri_parts"`
+	Parameters map[string]interface{} `json:"parameters,omitempty"`
+	Requester  Requester              `json:"requester"`
+}
+
+type automationRequestResults struct {
+	Results []AutomationRequest `json:"results"`
+}
+
+// Output returns the values returned by the Automate method run by the task.
+func (t RequestTask) Output() map[string]interface{} {
+	if ret, ok := t.Options["return"].(map[string]interface{}); ok {
+		return ret
+	}
+	return nil
+}
+
+func (c *Client) CreateAutomationRequest(ctx context.Context, uri AutomationURI, params map[string]interface{}, requester Requester) (*AutomationRequest, error) {
+	if uri.Namespace == "" || uri.Class == "" || uri.Instance == "" {
+		return nil, fmt.Errorf("namespace, class and instance can't be empty")
+	}
+	body := &automationRequestBody{
+		Version:    defaultAutomationRequestVersion,
+		URIParts:   uri,
+		Parameters: params,
+		Requester:  requester,
+	}
+
+	builder := NewRequestBuilder(POST).WithContext(ctx)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/automation_requests", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := builder.SetBodyContentJSON(body); err != nil {
+		return nil, err
+	}
+
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	r := &automationRequestResults{}
+	if err := json.Unmarshal(resp.RawResult, &r); err != nil {
+		return nil, err
+	}
+	if len(r.Results) == 0 {
+		return nil, fmt.Errorf("no automation request returned in the response")
+	}
+	return &r.Results[0], nil
+}
+
+func (c *Client) ListAutomationRequests(ctx context.Context, queries url.Values) (*AutomationRequests, error) {
+	builder := NewRequestBuilder(GET).WithContext(ctx)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/automation_requests", nil, queries)
+	if err != nil {
+		return nil, err
+	}
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	a := &AutomationRequests{}
+	if err := json.Unmarshal(resp.RawResult, &a); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// GetAutomationRequest returns the automation request along with its tasks.
+func (c *Client) GetAutomationRequest(ctx context.Context, id string, queries url.Values) (*AutomationRequest, error) {
+	queries = withList(queries, "attributes", "miq_request_tasks")
+	builder := NewRequestBuilder(GET).WithContext(ctx)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/automation_requests/"+id, nil, queries)
+	if err != nil {
+		return nil, err
+	}
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	a := &AutomationRequest{}
+	if err := json.Unmarshal(resp.RawResult, &a); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
@@ -0,0 +1,431 @@
+package manageiq
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+const (
+	ChargebackRateTypeCompute = "Compute"
+	ChargebackRateTypeStorage = "Storage"
+)
+
+type Chargebacks struct {
+	MangeIQListResource
+	Resources []ChargebackRate `json:"resources"`
+}
+
+type ChargebackRate struct {
+	Href        string    `json:"href,omitempty"`
+	ID          string    `json:"id,omitempty"`
+	GUID        string    `json:"guid,omitempty"`
+	Description string    `json:"description,omitempty"`
+	RateType    string    `json:"rate_type,omitempty"`
+	Default     bool      `json:"default,omitempty"`
+	CreatedOn   time.Time `json:"created_on"`
+	UpdatedOn   time.Time `json:"updated_on"`
+	Actions     []Action  `json:"actions,omitempty"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (c *ChargebackRate) UnmarshalJSON(b []byte) error {
+	type alias ChargebackRate
+	extra, err := unmarshalWithExtra(b, (*alias)(c))
+	if err != nil {
+		return err
+	}
+	c.Extra = extra
+	return nil
+}
+
+func (c ChargebackRate) MarshalJSON() ([]byte, error) {
+	type alias ChargebackRate
+	return marshalWithExtra(alias(c), c.Extra)
+}
+
+type Rates struct {
+	MangeIQListResource
+	Resources []ChargebackRateDetail `json:"resources"`
+}
+
+// ChargebackRateDetail is the rate of a chargeable field, the rate can be tiered by the consumption.
+type ChargebackRateDetail struct {
+	Href              string           `json:"href,omitempty"`
+	ID                string           `json:"id,omitempty"`
+	Description       string           `json:"description,omitempty"`
+	Group             string           `json:"group,omitempty"`
+	Source            string           `json:"source,omitempty"`
+	Metric            string           `json:"metric,omitempty"`
+	Enabled           *bool            `json:"enabled,omitempty"`
+	PerTime           string           `json:"per_time,omitempty"`
+	PerUnit           string           `json:"per_unit,omitempty"`
+	ChargebackRateID  string           `json:"chargeback_rate_id,omitempty"`
+	ChargeableFieldID string           `json:"chargeable_field_id,omitempty"`
+	CurrencyID        string           `json:"chargeback_rate_detail_currency_id,omitempty"`
+	Tiers             []ChargebackTier `json:"chargeback_tiers,omitempty"`
+	CreatedOn         time.Time        `json:"created_on"`
+	UpdatedOn         time.Time        `json:"updated_on"`
+	Actions           []Action         `json:"actions,omitempty"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (c *ChargebackRateDetail) UnmarshalJSON(b []byte) error {
+	type alias ChargebackRateDetail
+	extra, err := unmarshalWithExtra(b, (*alias)(c))
+	if err != nil {
+		return err
+	}
+	c.Extra = extra
+	return nil
+}
+
+func (c ChargebackRateDetail) MarshalJSON() ([]byte, error) {
+	type alias ChargebackRateDetail
+	return marshalWithExtra(alias(c), c.Extra)
+}
+
+type ChargebackTier struct {
+	Start float64 `json:"start"`
+	// Finish is nil for the last tier which has no upper bound
+	Finish       *float64 `json:"finish"`
+	FixedRate    float64  `json:"fixed_rate"`
+	VariableRate float64  `json:"variable_rate"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (c *ChargebackTier) UnmarshalJSON(b []byte) error {
+	type alias ChargebackTier
+	extra, err := unmarshalWithExtra(b, (*alias)(c))
+	if err != nil {
+		return err
+	}
+	c.Extra = extra
+	return nil
+}
+
+func (c ChargebackTier) MarshalJSON() ([]byte, error) {
+	type alias ChargebackTier
+	return marshalWithExtra(alias(c), c.Extra)
+}
+
+type Currencies struct {
+	MangeIQListResource
+	Resources []Currency `json:"resources"`
+}
+
+type Currency struct {
+	Href        string `json:"href"`
+	ID          string `json:"id"`
+	Code        string `json:"code"`
+	Name        string `json:"name"`
+	FullName    string `json:"full_name"`
+	Symbol      string `json:"symbol"`
+	UnicodeCode string `json:"unicode_code"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (c *Currency) UnmarshalJSON(b []byte) error {
+	type alias Currency
+	extra, err := unmarshalWithExtra(b, (*alias)(c))
+	if err != nil {
+		return err
+	}
+	c.Extra = extra
+	return nil
+}
+
+func (c Currency) MarshalJSON() ([]byte, error) {
+	type alias Currency
+	return marshalWithExtra(alias(c), c.Extra)
+}
+
+// ChargebackAssignment assigns a rate either to a tenant or to the resources tagged with the tag.
+type ChargebackAssignment struct {
+	Tenant *ResourceRef `json:"tenant,omitempty"`
+	// Tag in the form of /managed/<category>/<name>
+	Tag          string `json:"tag,omitempty"`
+	ResourceType string `json:"resource_type,omitempty"`
+}
+
+type chargebackAssignments struct {
+	Assignments []ChargebackAssignment `json:"assignments"`
+}
+
+type chargebackRateResults struct {
+	Results []ChargebackRate `json:"results"`
+}
+
+type chargebackRateDetailResults struct {
+	Results []ChargebackRateDetail `json:"results"`
+}
+
+func (c *Client) ListChargebacks(queries url.Values) (*Chargebacks, error) {
+	builder := NewRequestBuilder(GET)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/chargebacks", nil, queries)
+	if err != nil {
+		return nil, err
+	}
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	cb := &Chargebacks{}
+	if err := json.Unmarshal(resp.RawResult, &cb); err != nil {
+		return nil, err
+	}
+	return cb, nil
+}
+
+func (c *Client) GetChargebackRate(id string, queries url.Values) (*ChargebackRate, error) {
+	builder := NewRequestBuilder(GET)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/chargebacks/"+id, nil, queries)
+	if err != nil {
+		return nil, err
+	}
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	rate := &ChargebackRate{}
+	if err := json.Unmarshal(resp.RawResult, &rate); err != nil {
+		return nil, err
+	}
+	return rate, nil
+}
+
+func (c *Client) CreateChargebackRate(ctx context.Context, rate *ChargebackRate) (*ChargebackRate, error) {
+	builder := NewRequestBuilder(POST).WithContext(ctx)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/chargebacks", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := builder.SetBodyContentJSON(&actionBody{Action: "create", Resource: rate}); err != nil {
+		return nil, err
+	}
+
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	r := &chargebackRateResults{}
+	if err := json.Unmarshal(resp.RawResult, &r); err != nil {
+		return nil, err
+	}
+	if len(r.Results) == 0 {
+		return nil, fmt.Errorf("no chargeback rate returned in the response")
+	}
+	return &r.Results[0], nil
+}
+
+func (c *Client) UpdateChargebackRate(ctx context.Context, id string, rate *ChargebackRate) (*ChargebackRate, error) {
+	builder := NewRequestBuilder(POST).WithContext(ctx)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/chargebacks/"+id, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := builder.SetBodyContentJSON(&actionBody{Action: "edit", Resource: rate}); err != nil {
+		return nil, err
+	}
+
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	u := &ChargebackRate{}
+	if err := json.Unmarshal(resp.RawResult, &u); err != nil {
+		return nil, err
+	}
+	return u, nil
+}
+
+func (c *Client) DeleteChargebackRate(ctx context.Context, id string) (*ActionResult, error) {
+	return c.doAction(ctx, POST, c.Authenticator.GetBaseURL(), "/chargebacks/"+id, "delete", nil)
+}
+
+func (c *Client) ListRates(queries url.Values) (*Rates, error) {
+	builder := NewRequestBuilder(GET)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/rates", nil, queries)
+	if err != nil {
+		return nil, err
+	}
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	rates := &Rates{}
+	if err := json.Unmarshal(resp.RawResult, &rates); err != nil {
+		return nil, err
+	}
+	return rates, nil
+}
+
+func (c *Client) GetChargebackRateDetail(id string, queries url.Values) (*ChargebackRateDetail, error) {
+	builder := NewRequestBuilder(GET)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/rates/"+id, nil, queries)
+	if err != nil {
+		return nil, err
+	}
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	d := &ChargebackRateDetail{}
+	if err := json.Unmarshal(resp.RawResult, &d); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+func (c *Client) CreateChargebackRateDetail(ctx context.Context, d *ChargebackRateDetail) (*ChargebackRateDetail, error) {
+	builder := NewRequestBuilder(POST).WithContext(ctx)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/rates", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := builder.SetBodyContentJSON(&actionBody{Action: "create", Resource: d}); err != nil {
+		return nil, err
+	}
+
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	r := &chargebackRateDetailResults{}
+	if err := json.Unmarshal(resp.RawResult, &r); err != nil {
+		return nil, err
+	}
+	if len(r.Results) == 0 {
+		return nil, fmt.Errorf("no chargeback rate detail returned in the response")
+	}
+	return &r.Results[0], nil
+}
+
+func (c *Client) UpdateChargebackRateDetail(ctx context.Context, id string, d *ChargebackRateDetail) (*ChargebackRateDetail, error) {
+	builder := NewRequestBuilder(POST).WithContext(ctx)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/rates/"+id, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := builder.SetBodyContentJSON(&actionBody{Action: "edit", Resource: d}); err != nil {
+		return nil, err
+	}
+
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	u := &ChargebackRateDetail{}
+	if err := json.Unmarshal(resp.RawResult, &u); err != nil {
+		return nil, err
+	}
+	return u, nil
+}
+
+func (c *Client) DeleteChargebackRateDetail(ctx context.Context, id string) (*ActionResult, error) {
+	return c.doAction(ctx, POST, c.Authenticator.GetBaseURL(), "/rates/"+id, "delete", nil)
+}
+
+// ListChargebackRateDetails returns the rate details of the chargeback rate.
+func (c *Client) ListChargebackRateDetails(id string, queries url.Values) (*Rates, error) {
+	builder := NewRequestBuilder(GET)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/chargebacks/"+id+"/rates", nil, withList(queries, "expand", "resources"))
+	if err != nil {
+		return nil, err
+	}
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	r := &Rates{}
+	if err := json.Unmarshal(resp.RawResult, &r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (c *Client) ListCurrencies(queries url.Values) (*Currencies, error) {
+	builder := NewRequestBuilder(GET)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/currencies", nil, queries)
+	if err != nil {
+		return nil, err
+	}
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	cur := &Currencies{}
+	if err := json.Unmarshal(resp.RawResult, &cur); err != nil {
+		return nil, err
+	}
+	return cur, nil
+}
+
+// AssignChargebackRate replaces the assignments of the chargeback rate with the given ones.
+func (c *Client) AssignChargebackRate(ctx context.Context, id string, assignments []ChargebackAssignment) (*ActionResult, error) {
+	return c.doAction(ctx, POST, c.Authenticator.GetBaseURL(), "/chargebacks/"+id, "assign", &chargebackAssignments{Assignments: assignments})
+}
+
+func (c *Client) UnassignChargebackRate(ctx context.Context, id string, assignments []ChargebackAssignment) (*ActionResult, error) {
+	return c.doAction(ctx, POST, c.Authenticator.GetBaseURL(), "/chargebacks/"+id, "unassign", &chargebackAssignments{Assignments: assignments})
+}
@@ -104,6 +104,9 @@ func (c *Client) sendRequest(req *http.Request, v interface{}) (*DetailedRespons
 		Result:     v,
 		RawResult:  body,
 	}
+	if len(body) == 0 {
+		return detailedResponse, nil
+	}
 	if err = json.NewDecoder(bytes.NewReader(body)).Decode(&detailedResponse.Result); err != nil {
 		return nil, err
 	}
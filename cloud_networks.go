@@ -0,0 +1,598 @@
+package manageiq
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+const (
+	FirewallRuleIngress = "ingress"
+	FirewallRuleEgress  = "egress"
+)
+
+type CloudNetworks struct {
+	MangeIQListResource
+	Resources []CloudNetwork `json:"resources"`
+}
+
+type CloudNetwork struct {
+	Href                    string   `json:"href"`
+	ID                      string   `json:"id"`
+	Name                    string   `json:"name"`
+	Type                    string   `json:"type"`
+	EMSID                   string   `json:"ems_id"`
+	EMSRef                  string   `json:"ems_ref"`
+	CIDR                    string   `json:"cidr"`
+	Status                  string   `json:"status"`
+	Enabled                 bool     `json:"enabled"`
+	ExternalFacing          bool     `json:"external_facing"`
+	Shared                  bool     `json:"shared"`
+	ProviderNetworkType     string   `json:"provider_network_type"`
+	ProviderPhysicalNetwork string   `json:"provider_physical_network"`
+	ProviderSegmentationID  string   `json:"provider_segmentation_id"`
+	CloudTenantID           string   `json:"cloud_tenant_id"`
+	Actions                 []Action `json:"actions"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (c *CloudNetwork) UnmarshalJSON(b []byte) error {
+	type alias CloudNetwork
+	extra, err := unmarshalWithExtra(b, (*alias)(c))
+	if err != nil {
+		return err
+	}
+	c.Extra = extra
+	return nil
+}
+
+func (c CloudNetwork) MarshalJSON() ([]byte, error) {
+	type alias CloudNetwork
+	return marshalWithExtra(alias(c), c.Extra)
+}
+
+type CloudNetworkParams struct {
+	EMSID                   string       `json:"ems_id,omitempty"`
+	Name                    string       `json:"name,omitempty"`
+	AdminStateUp            *bool        `json:"admin_state_up,omitempty"`
+	External                *bool        `json:"external_facing,omitempty"`
+	Shared                  *bool        `json:"shared,omitempty"`
+	ProviderNetworkType     string       `json:"provider_network_type,omitempty"`
+	ProviderPhysicalNetwork string       `json:"provider_physical_network,omitempty"`
+	ProviderSegmentationID  string       `json:"provider_segmentation_id,omitempty"`
+	CloudTenant             *ResourceRef `json:"cloud_tenant,omitempty"`
+}
+
+type CloudSubnets struct {
+	MangeIQListResource
+	Resources []CloudSubnet `json:"resources"`
+}
+
+type CloudSubnet struct {
+	Href               string   `json:"href"`
+	ID                 string   `json:"id"`
+	Name               string   `json:"name"`
+	Type               string   `json:"type"`
+	EMSID              string   `json:"ems_id"`
+	EMSRef             string   `json:"ems_ref"`
+	CIDR               string   `json:"cidr"`
+	Gateway            string   `json:"gateway"`
+	IPVersion          string   `json:"ip_version"`
+	DHCPEnabled        bool     `json:"dhcp_enabled"`
+	NetworkProtocol    string   `json:"network_protocol"`
+	DNSNameservers     []string `json:"dns_nameservers"`
+	Status             string   `json:"status"`
+	CloudNetworkID     string   `json:"cloud_network_id"`
+	CloudTenantID      string   `json:"cloud_tenant_id"`
+	AvailabilityZoneID string   `json:"availability_zone_id"`
+	Actions            []Action `json:"actions"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (c *CloudSubnet) UnmarshalJSON(b []byte) error {
+	type alias CloudSubnet
+	extra, err := unmarshalWithExtra(b, (*alias)(c))
+	if err != nil {
+		return err
+	}
+	c.Extra = extra
+	return nil
+}
+
+func (c CloudSubnet) MarshalJSON() ([]byte, error) {
+	type alias CloudSubnet
+	return marshalWithExtra(alias(c), c.Extra)
+}
+
+type CloudSubnetParams struct {
+	EMSID          string       `json:"ems_id,omitempty"`
+	Name           string       `json:"name,omitempty"`
+	CIDR           string       `json:"cidr,omitempty"`
+	Gateway        string       `json:"gateway_ip,omitempty"`
+	IPVersion      int          `json:"ip_version,omitempty"`
+	DHCPEnabled    *bool        `json:"enable_dhcp,omitempty"`
+	DNSNameservers []string     `json:"dns_nameservers,omitempty"`
+	CloudNetworkID string       `json:"network_id,omitempty"`
+	CloudTenant    *ResourceRef `json:"cloud_tenant,omitempty"`
+}
+
+type NetworkRouters struct {
+	MangeIQListResource
+	Resources []NetworkRouter `json:"resources"`
+}
+
+type NetworkRouter struct {
+	Href                string                 `json:"href"`
+	ID                  string                 `json:"id"`
+	Name                string                 `json:"name"`
+	Type                string                 `json:"type"`
+	EMSID               string                 `json:"ems_id"`
+	EMSRef              string                 `json:"ems_ref"`
+	AdminStateUp        bool                   `json:"admin_state_up"`
+	Status              string                 `json:"status"`
+	ExternalGatewayInfo map[string]interface{} `json:"external_gateway_info"`
+	CloudNetworkID      string                 `json:"cloud_network_id"`
+	CloudTenantID       string                 `json:"cloud_tenant_id"`
+	Actions             []Action               `json:"actions"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (n *NetworkRouter) UnmarshalJSON(b []byte) error {
+	type alias NetworkRouter
+	extra, err := unmarshalWithExtra(b, (*alias)(n))
+	if err != nil {
+		return err
+	}
+	n.Extra = extra
+	return nil
+}
+
+func (n NetworkRouter) MarshalJSON() ([]byte, error) {
+	type alias NetworkRouter
+	return marshalWithExtra(alias(n), n.Extra)
+}
+
+type NetworkRouterParams struct {
+	EMSID               string                 `json:"ems_id,omitempty"`
+	Name                string                 `json:"name,omitempty"`
+	AdminStateUp        *bool                  `json:"admin_state_up,omitempty"`
+	ExternalGatewayInfo map[string]interface{} `json:"external_gateway_info,omitempty"`
+	CloudTenant         *ResourceRef           `json:"cloud_tenant,omitempty"`
+}
+
+type SecurityGroups struct {
+	MangeIQListResource
+	Resources []SecurityGroup `json:"resources"`
+}
+
+type SecurityGroup struct {
+	Href           string   `json:"href"`
+	ID             string   `json:"id"`
+	Name           string   `json:"name"`
+	Description    string   `json:"description"`
+	Type           string   `json:"type"`
+	EMSID          string   `json:"ems_id"`
+	EMSRef         string   `json:"ems_ref"`
+	CloudNetworkID string   `json:"cloud_network_id"`
+	CloudTenantID  string   `json:"cloud_tenant_id"`
+	Actions        []Action `json:"actions"`
+	// Only populated when requested with attributes=firewall_rules
+	FirewallRules []FirewallRule `json:"firewall_rules"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (s *SecurityGroup) UnmarshalJSON(b []byte) error {
+	type alias SecurityGroup
+	extra, err := unmarshalWithExtra(b, (*alias)(s))
+	if err != nil {
+		return err
+	}
+	s.Extra = extra
+	return nil
+}
+
+func (s SecurityGroup) MarshalJSON() ([]byte, error) {
+	type alias SecurityGroup
+	return marshalWithExtra(alias(s), s.Extra)
+}
+
+type SecurityGroupParams struct {
+	EMSID       string       `json:"ems_id,omitempty"`
+	Name        string       `json:"name,omitempty"`
+	Description string       `json:"description,omitempty"`
+	CloudTenant *ResourceRef `json:"cloud_tenant,omitempty"`
+}
+
+type FirewallRule struct {
+	ID                    string `json:"id,omitempty"`
+	EMSRef                string `json:"ems_ref,omitempty"`
+	Direction             string `json:"direction,omitempty"`
+	NetworkProtocol       string `json:"network_protocol,omitempty"`
+	HostProtocol          string `json:"host_protocol,omitempty"`
+	Port                  int    `json:"port,omitempty"`
+	EndPort               int    `json:"end_port,omitempty"`
+	SourceIPRange         string `json:"source_ip_range,omitempty"`
+	SourceSecurityGroupID string `json:"source_security_group_id,omitempty"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (f *FirewallRule) UnmarshalJSON(b []byte) error {
+	type alias FirewallRule
+	extra, err := unmarshalWithExtra(b, (*alias)(f))
+	if err != nil {
+		return err
+	}
+	f.Extra = extra
+	return nil
+}
+
+func (f FirewallRule) MarshalJSON() ([]byte, error) {
+	type alias FirewallRule
+	return marshalWithExtra(alias(f), f.Extra)
+}
+
+type FloatingIPs struct {
+	MangeIQListResource
+	Resources []FloatingIP `json:"resources"`
+}
+
+type FloatingIP struct {
+	Href           string   `json:"href"`
+	ID             string   `json:"id"`
+	Type           string   `json:"type"`
+	EMSID          string   `json:"ems_id"`
+	EMSRef         string   `json:"ems_ref"`
+	Address        string   `json:"address"`
+	FixedIPAddress string   `json:"fixed_ip_address"`
+	Status         string   `json:"status"`
+	CloudNetworkID string   `json:"cloud_network_id"`
+	CloudTenantID  string   `json:"cloud_tenant_id"`
+	NetworkPortID  string   `json:"network_port_id"`
+	VMID           string   `json:"vm_id"`
+	Actions        []Action `json:"actions"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (f *FloatingIP) UnmarshalJSON(b []byte) error {
+	type alias FloatingIP
+	extra, err := unmarshalWithExtra(b, (*alias)(f))
+	if err != nil {
+		return err
+	}
+	f.Extra = extra
+	return nil
+}
+
+func (f FloatingIP) MarshalJSON() ([]byte, error) {
+	type alias FloatingIP
+	return marshalWithExtra(alias(f), f.Extra)
+}
+
+type FloatingIPParams struct {
+	EMSID             string       `json:"ems_id,omitempty"`
+	CloudNetworkID    string       `json:"cloud_network_id,omitempty"`
+	Address           string       `json:"floating_ip_address,omitempty"`
+	FixedIPAddress    string       `json:"fixed_ip_address,omitempty"`
+	NetworkPortEMSRef string       `json:"network_port_ems_ref,omitempty"`
+	CloudTenant       *ResourceRef `json:"cloud_tenant,omitempty"`
+}
+
+func (c *Client) ListCloudNetworks(queries url.Values) (*CloudNetworks, error) {
+	builder := NewRequestBuilder(GET)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/cloud_networks", nil, queries)
+	if err != nil {
+		return nil, err
+	}
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	n := &CloudNetworks{}
+	if err := json.Unmarshal(resp.RawResult, &n); err != nil {
+		return nil, err
+	}
+	return n, nil
+}
+
+func (c *Client) GetCloudNetwork(id string, queries url.Values) (*CloudNetwork, error) {
+	builder := NewRequestBuilder(GET)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/cloud_networks/"+id, nil, queries)
+	if err != nil {
+		return nil, err
+	}
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	n := &CloudNetwork{}
+	if err := json.Unmarshal(resp.RawResult, &n); err != nil {
+		return nil, err
+	}
+	return n, nil
+}
+
+func (c *Client) CreateCloudNetwork(ctx context.Context, providerID string, params *CloudNetworkParams) (*ActionResult, error) {
+	if params == nil {
+		return nil, fmt.Errorf("params can't be nil")
+	}
+	body := *params
+	body.EMSID = providerID
+	return c.doCollectionAction(ctx, "/cloud_networks", "create", &body)
+}
+
+func (c *Client) UpdateCloudNetwork(ctx context.Context, id string, params *CloudNetworkParams) (*ActionResult, error) {
+	return c.doAction(ctx, POST, c.Authenticator.GetBaseURL(), "/cloud_networks/"+id, "edit", params)
+}
+
+func (c *Client) DeleteCloudNetwork(ctx context.Context, id string) (*ActionResult, error) {
+	return c.doAction(ctx, POST, c.Authenticator.GetBaseURL(), "/cloud_networks/"+id, "delete", nil)
+}
+
+func (c *Client) ListCloudSubnets(queries url.Values) (*CloudSubnets, error) {
+	builder := NewRequestBuilder(GET)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/cloud_subnets", nil, queries)
+	if err != nil {
+		return nil, err
+	}
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	s := &CloudSubnets{}
+	if err := json.Unmarshal(resp.RawResult, &s); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (c *Client) GetCloudSubnet(id string, queries url.Values) (*CloudSubnet, error) {
+	builder := NewRequestBuilder(GET)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/cloud_subnets/"+id, nil, queries)
+	if err != nil {
+		return nil, err
+	}
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	s := &CloudSubnet{}
+	if err := json.Unmarshal(resp.RawResult, &s); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (c *Client) CreateCloudSubnet(ctx context.Context, providerID string, params *CloudSubnetParams) (*ActionResult, error) {
+	if params == nil {
+		return nil, fmt.Errorf("params can't be nil")
+	}
+	body := *params
+	body.EMSID = providerID
+	return c.doCollectionAction(ctx, "/cloud_subnets", "create", &body)
+}
+
+func (c *Client) UpdateCloudSubnet(ctx context.Context, id string, params *CloudSubnetParams) (*ActionResult, error) {
+	return c.doAction(ctx, POST, c.Authenticator.GetBaseURL(), "/cloud_subnets/"+id, "edit", params)
+}
+
+func (c *Client) DeleteCloudSubnet(ctx context.Context, id string) (*ActionResult, error) {
+	return c.doAction(ctx, POST, c.Authenticator.GetBaseURL(), "/cloud_subnets/"+id, "delete", nil)
+}
+
+func (c *Client) ListNetworkRouters(queries url.Values) (*NetworkRouters, error) {
+	builder := NewRequestBuilder(GET)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/network_routers", nil, queries)
+	if err != nil {
+		return nil, err
+	}
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	n := &NetworkRouters{}
+	if err := json.Unmarshal(resp.RawResult, &n); err != nil {
+		return nil, err
+	}
+	return n, nil
+}
+
+func (c *Client) GetNetworkRouter(id string, queries url.Values) (*NetworkRouter, error) {
+	builder := NewRequestBuilder(GET)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/network_routers/"+id, nil, queries)
+	if err != nil {
+		return nil, err
+	}
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	n := &NetworkRouter{}
+	if err := json.Unmarshal(resp.RawResult, &n); err != nil {
+		return nil, err
+	}
+	return n, nil
+}
+
+func (c *Client) CreateNetworkRouter(ctx context.Context, providerID string, params *NetworkRouterParams) (*ActionResult, error) {
+	if params == nil {
+		return nil, fmt.Errorf("params can't be nil")
+	}
+	body := *params
+	body.EMSID = providerID
+	return c.doCollectionAction(ctx, "/network_routers", "create", &body)
+}
+
+func (c *Client) UpdateNetworkRouter(ctx context.Context, id string, params *NetworkRouterParams) (*ActionResult, error) {
+	return c.doAction(ctx, POST, c.Authenticator.GetBaseURL(), "/network_routers/"+id, "edit", params)
+}
+
+func (c *Client) DeleteNetworkRouter(ctx context.Context, id string) (*ActionResult, error) {
+	return c.doAction(ctx, POST, c.Authenticator.GetBaseURL(), "/network_routers/"+id, "delete", nil)
+}
+
+func (c *Client) ListSecurityGroups(queries url.Values) (*SecurityGroups, error) {
+	builder := NewRequestBuilder(GET)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/security_groups", nil, queries)
+	if err != nil {
+		return nil, err
+	}
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	s := &SecurityGroups{}
+	if err := json.Unmarshal(resp.RawResult, &s); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (c *Client) GetSecurityGroup(id string, queries url.Values) (*SecurityGroup, error) {
+	builder := NewRequestBuilder(GET)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/security_groups/"+id, nil, queries)
+	if err != nil {
+		return nil, err
+	}
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	s := &SecurityGroup{}
+	if err := json.Unmarshal(resp.RawResult, &s); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (c *Client) CreateSecurityGroup(ctx context.Context, providerID string, params *SecurityGroupParams) (*ActionResult, error) {
+	if params == nil {
+		return nil, fmt.Errorf("params can't be nil")
+	}
+	body := *params
+	body.EMSID = providerID
+	return c.doCollectionAction(ctx, "/security_groups", "create", &body)
+}
+
+func (c *Client) UpdateSecurityGroup(ctx context.Context, id string, params *SecurityGroupParams) (*ActionResult, error) {
+	return c.doAction(ctx, POST, c.Authenticator.GetBaseURL(), "/security_groups/"+id, "edit", params)
+}
+
+func (c *Client) DeleteSecurityGroup(ctx context.Context, id string) (*ActionResult, error) {
+	return c.doAction(ctx, POST, c.Authenticator.GetBaseURL(), "/security_groups/"+id, "delete", nil)
+}
+
+func (c *Client) ListFloatingIPs(queries url.Values) (*FloatingIPs, error) {
+	builder := NewRequestBuilder(GET)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/floating_ips", nil, queries)
+	if err != nil {
+		return nil, err
+	}
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	f := &FloatingIPs{}
+	if err := json.Unmarshal(resp.RawResult, &f); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+func (c *Client) GetFloatingIP(id string, queries url.Values) (*FloatingIP, error) {
+	builder := NewRequestBuilder(GET)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/floating_ips/"+id, nil, queries)
+	if err != nil {
+		return nil, err
+	}
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	f := &FloatingIP{}
+	if err := json.Unmarshal(resp.RawResult, &f); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+func (c *Client) CreateFloatingIP(ctx context.Context, providerID string, params *FloatingIPParams) (*ActionResult, error) {
+	if params == nil {
+		return nil, fmt.Errorf("params can't be nil")
+	}
+	body := *params
+	body.EMSID = providerID
+	return c.doCollectionAction(ctx, "/floating_ips", "create", &body)
+}
+
+func (c *Client) UpdateFloatingIP(ctx context.Context, id string, params *FloatingIPParams) (*ActionResult, error) {
+	return c.doAction(ctx, POST, c.Authenticator.GetBaseURL(), "/floating_ips/"+id, "edit", params)
+}
+
+func (c *Client) DeleteFloatingIP(ctx context.Context, id string) (*ActionResult, error) {
+	return c.doAction(ctx, POST, c.Authenticator.GetBaseURL(), "/floating_ips/"+id, "delete", nil)
+}
+
+func (c *Client) AddFirewallRule(ctx context.Context, securityGroupID string, rule *FirewallRule) (*ActionResult, error) {
+	return c.doAction(ctx, POST, c.Authenticator.GetBaseURL(), "/security_groups/"+securityGroupID, "add_firewall_rule", rule)
+}
+
+func (c *Client) RemoveFirewallRule(ctx context.Context, securityGroupID, ruleID string) (*ActionResult, error) {
+	return c.doAction(ctx, POST, c.Authenticator.GetBaseURL(), "/security_groups/"+securityGroupID, "remove_firewall_rule", &FirewallRule{ID: ruleID})
+}
@@ -0,0 +1,376 @@
+package manageiq
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+type CloudTenants struct {
+	MangeIQListResource
+	Resources []CloudTenant `json:"resources"`
+}
+
+type CloudTenant struct {
+	Href        string   `json:"href"`
+	ID          string   `json:"id"`
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	Type        string   `json:"type"`
+	Enabled     bool     `json:"enabled"`
+	EMSID       string   `json:"ems_id"`
+	EMSRef      string   `json:"ems_ref"`
+	ParentID    string   `json:"parent_id"`
+	Actions     []Action `json:"actions"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (c *CloudTenant) UnmarshalJSON(b []byte) error {
+	type alias CloudTenant
+	extra, err := unmarshalWithExtra(b, (*alias)(c))
+	if err != nil {
+		return err
+	}
+	c.Extra = extra
+	return nil
+}
+
+func (c CloudTenant) MarshalJSON() ([]byte, error) {
+	type alias CloudTenant
+	return marshalWithExtra(alias(c), c.Extra)
+}
+
+type Flavors struct {
+	MangeIQListResource
+	Resources []Flavor `json:"resources"`
+}
+
+type Flavor struct {
+	Href              string `json:"href"`
+	ID                string `json:"id"`
+	Name              string `json:"name"`
+	Description       string `json:"description"`
+	Type              string `json:"type"`
+	EMSID             string `json:"ems_id"`
+	EMSRef            string `json:"ems_ref"`
+	CPUTotalCores     int    `json:"cpu_total_cores"`
+	CPUCoresPerSocket int    `json:"cpu_cores_per_socket"`
+	// Memory and disk sizes in bytes
+	Memory            int64    `json:"memory"`
+	RootDiskSize      int64    `json:"root_disk_size"`
+	SwapDiskSize      int64    `json:"swap_disk_size"`
+	EphemeralDiskSize int64    `json:"ephemeral_disk_size"`
+	PubliclyAvailable bool     `json:"publicly_available"`
+	Enabled           bool     `json:"enabled"`
+	Actions           []Action `json:"actions"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (f *Flavor) UnmarshalJSON(b []byte) error {
+	type alias Flavor
+	extra, err := unmarshalWithExtra(b, (*alias)(f))
+	if err != nil {
+		return err
+	}
+	f.Extra = extra
+	return nil
+}
+
+func (f Flavor) MarshalJSON() ([]byte, error) {
+	type alias Flavor
+	return marshalWithExtra(alias(f), f.Extra)
+}
+
+type FlavorParams struct {
+	EMSID string `json:"ems_id,omitempty"`
+	Name  string `json:"name"`
+	VCPUs int    `json:"vcpus"`
+	// Memory in MB and disk sizes in GB
+	RAM          int           `json:"ram"`
+	Disk         int           `json:"disk"`
+	Swap         int           `json:"swap,omitempty"`
+	Ephemeral    int           `json:"ephemeral,omitempty"`
+	RxTxFactor   float64       `json:"rxtx_factor,omitempty"`
+	IsPublic     *bool         `json:"is_public,omitempty"`
+	CloudTenants []ResourceRef `json:"cloud_tenants,omitempty"`
+}
+
+type AvailabilityZones struct {
+	MangeIQListResource
+	Resources []AvailabilityZone `json:"resources"`
+}
+
+type AvailabilityZone struct {
+	Href    string   `json:"href"`
+	ID      string   `json:"id"`
+	Name    string   `json:"name"`
+	Type    string   `json:"type"`
+	EMSID   string   `json:"ems_id"`
+	EMSRef  string   `json:"ems_ref"`
+	Actions []Action `json:"actions"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (a *AvailabilityZone) UnmarshalJSON(b []byte) error {
+	type alias AvailabilityZone
+	extra, err := unmarshalWithExtra(b, (*alias)(a))
+	if err != nil {
+		return err
+	}
+	a.Extra = extra
+	return nil
+}
+
+func (a AvailabilityZone) MarshalJSON() ([]byte, error) {
+	type alias AvailabilityZone
+	return marshalWithExtra(alias(a), a.Extra)
+}
+
+type AuthKeyPairs struct {
+	MangeIQListResource
+	Resources []AuthKeyPair `json:"resources"`
+}
+
+type AuthKeyPair struct {
+	Href        string    `json:"href"`
+	ID          string    `json:"id"`
+	Name        string    `json:"name"`
+	Type        string    `json:"type"`
+	Fingerprint string    `json:"fingerprint"`
+	PublicKey   string    `json:"public_key"`
+	ResourceID  string    `json:"resource_id"`
+	CreatedOn   time.Time `json:"created_on"`
+	UpdatedOn   time.Time `json:"updated_on"`
+	Actions     []Action  `json:"actions"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (a *AuthKeyPair) UnmarshalJSON(b []byte) error {
+	type alias AuthKeyPair
+	extra, err := unmarshalWithExtra(b, (*alias)(a))
+	if err != nil {
+		return err
+	}
+	a.Extra = extra
+	return nil
+}
+
+func (a AuthKeyPair) MarshalJSON() ([]byte, error) {
+	type alias AuthKeyPair
+	return marshalWithExtra(alias(a), a.Extra)
+}
+
+type AuthKeyPairParams struct {
+	EMSID string `json:"ems_id,omitempty"`
+	Name  string `json:"name"`
+	// PublicKey is imported when set, otherwise a new key pair is generated by the provider
+	PublicKey string `json:"public_key,omitempty"`
+}
+
+func (c *Client) ListCloudTenants(queries url.Values) (*CloudTenants, error) {
+	builder := NewRequestBuilder(GET)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/cloud_tenants", nil, queries)
+	if err != nil {
+		return nil, err
+	}
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	t := &CloudTenants{}
+	if err := json.Unmarshal(resp.RawResult, &t); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+func (c *Client) GetCloudTenant(id string, queries url.Values) (*CloudTenant, error) {
+	builder := NewRequestBuilder(GET)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/cloud_tenants/"+id, nil, queries)
+	if err != nil {
+		return nil, err
+	}
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	t := &CloudTenant{}
+	if err := json.Unmarshal(resp.RawResult, &t); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+func (c *Client) ListFlavors(queries url.Values) (*Flavors, error) {
+	builder := NewRequestBuilder(GET)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/flavors", nil, queries)
+	if err != nil {
+		return nil, err
+	}
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	f := &Flavors{}
+	if err := json.Unmarshal(resp.RawResult, &f); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+func (c *Client) GetFlavor(id string, queries url.Values) (*Flavor, error) {
+	builder := NewRequestBuilder(GET)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/flavors/"+id, nil, queries)
+	if err != nil {
+		return nil, err
+	}
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	f := &Flavor{}
+	if err := json.Unmarshal(resp.RawResult, &f); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+func (c *Client) CreateFlavor(ctx context.Context, providerID string, params *FlavorParams) (*ActionResult, error) {
+	if params == nil {
+		return nil, fmt.Errorf("params can't be nil")
+	}
+	body := *params
+	body.EMSID = providerID
+	return c.doCollectionAction(ctx, "/flavors", "create", &body)
+}
+
+func (c *Client) DeleteFlavor(ctx context.Context, id string) (*ActionResult, error) {
+	return c.doAction(ctx, POST, c.Authenticator.GetBaseURL(), "/flavors/"+id, "delete", nil)
+}
+
+func (c *Client) ListAvailabilityZones(queries url.Values) (*AvailabilityZones, error) {
+	builder := NewRequestBuilder(GET)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/availability_zones", nil, queries)
+	if err != nil {
+		return nil, err
+	}
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	z := &AvailabilityZones{}
+	if err := json.Unmarshal(resp.RawResult, &z); err != nil {
+		return nil, err
+	}
+	return z, nil
+}
+
+func (c *Client) GetAvailabilityZone(id string, queries url.Values) (*AvailabilityZone, error) {
+	builder := NewRequestBuilder(GET)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/availability_zones/"+id, nil, queries)
+	if err != nil {
+		return nil, err
+	}
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	z := &AvailabilityZone{}
+	if err := json.Unmarshal(resp.RawResult, &z); err != nil {
+		return nil, err
+	}
+	return z, nil
+}
+
+func (c *Client) ListAuthKeyPairs(queries url.Values) (*AuthKeyPairs, error) {
+	builder := NewRequestBuilder(GET)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/auth_key_pairs", nil, queries)
+	if err != nil {
+		return nil, err
+	}
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	k := &AuthKeyPairs{}
+	if err := json.Unmarshal(resp.RawResult, &k); err != nil {
+		return nil, err
+	}
+	return k, nil
+}
+
+func (c *Client) GetAuthKeyPair(id string, queries url.Values) (*AuthKeyPair, error) {
+	builder := NewRequestBuilder(GET)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/auth_key_pairs/"+id, nil, queries)
+	if err != nil {
+		return nil, err
+	}
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	k := &AuthKeyPair{}
+	if err := json.Unmarshal(resp.RawResult, &k); err != nil {
+		return nil, err
+	}
+	return k, nil
+}
+
+func (c *Client) CreateAuthKeyPair(ctx context.Context, providerID string, params *AuthKeyPairParams) (*ActionResult, error) {
+	if params == nil {
+		return nil, fmt.Errorf("params can't be nil")
+	}
+	body := *params
+	body.EMSID = providerID
+	return c.doCollectionAction(ctx, "/auth_key_pairs", "create", &body)
+}
+
+func (c *Client) DeleteAuthKeyPair(ctx context.Context, id string) (*ActionResult, error) {
+	return c.doAction(ctx, POST, c.Authenticator.GetBaseURL(), "/auth_key_pairs/"+id, "delete", nil)
+}
@@ -0,0 +1,236 @@
+package manageiq
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+type CloudVolumes struct {
+	MangeIQListResource
+	Resources []CloudVolume `json:"resources"`
+}
+
+type CloudVolume struct {
+	Href        string `json:"href"`
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Type        string `json:"type"`
+	EMSID       string `json:"ems_id"`
+	EMSRef      string `json:"ems_ref"`
+	// Size in bytes
+	Size               int64     `json:"size"`
+	Status             string    `json:"status"`
+	VolumeType         string    `json:"volume_type"`
+	Bootable           bool      `json:"bootable"`
+	MultiAttachment    bool      `json:"multi_attachment"`
+	Encrypted          bool      `json:"encrypted"`
+	CloudTenantID      string    `json:"cloud_tenant_id"`
+	AvailabilityZoneID string    `json:"availability_zone_id"`
+	CreationTime       time.Time `json:"creation_time"`
+	Actions            []Action  `json:"actions"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (c *CloudVolume) UnmarshalJSON(b []byte) error {
+	type alias CloudVolume
+	extra, err := unmarshalWithExtra(b, (*alias)(c))
+	if err != nil {
+		return err
+	}
+	c.Extra = extra
+	return nil
+}
+
+func (c CloudVolume) MarshalJSON() ([]byte, error) {
+	type alias CloudVolume
+	return marshalWithExtra(alias(c), c.Extra)
+}
+
+type CloudVolumeParams struct {
+	EMSID       string `json:"ems_id,omitempty"`
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+	// Size in GB
+	Size             int          `json:"size,omitempty"`
+	VolumeType       string       `json:"volume_type,omitempty"`
+	MultiAttachment  *bool        `json:"multi_attachment,omitempty"`
+	AvailabilityZone *ResourceRef `json:"availability_zone,omitempty"`
+	CloudTenant      *ResourceRef `json:"cloud_tenant,omitempty"`
+}
+
+type volumeAttachment struct {
+	VMID   string `json:"vm_id"`
+	Device string `json:"device,omitempty"`
+}
+
+type CloudVolumeSnapshots struct {
+	MangeIQListResource
+	Resources []CloudVolumeSnapshot `json:"resources"`
+}
+
+type CloudVolumeSnapshot struct {
+	Href        string `json:"href"`
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Type        string `json:"type"`
+	EMSID       string `json:"ems_id"`
+	EMSRef      string `json:"ems_ref"`
+	// Size in bytes
+	Size          int64     `json:"size"`
+	Status        string    `json:"status"`
+	CloudVolumeID string    `json:"cloud_volume_id"`
+	CloudTenantID string    `json:"cloud_tenant_id"`
+	CreationTime  time.Time `json:"creation_time"`
+	Actions       []Action  `json:"actions"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (c *CloudVolumeSnapshot) UnmarshalJSON(b []byte) error {
+	type alias CloudVolumeSnapshot
+	extra, err := unmarshalWithExtra(b, (*alias)(c))
+	if err != nil {
+		return err
+	}
+	c.Extra = extra
+	return nil
+}
+
+func (c CloudVolumeSnapshot) MarshalJSON() ([]byte, error) {
+	type alias CloudVolumeSnapshot
+	return marshalWithExtra(alias(c), c.Extra)
+}
+
+type CloudVolumeSnapshotParams struct {
+	Name          string `json:"name"`
+	Description   string `json:"description,omitempty"`
+	CloudVolumeID string `json:"cloud_volume_id"`
+}
+
+func (c *Client) ListCloudVolumes(queries url.Values) (*CloudVolumes, error) {
+	builder := NewRequestBuilder(GET)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/cloud_volumes", nil, queries)
+	if err != nil {
+		return nil, err
+	}
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	v := &CloudVolumes{}
+	if err := json.Unmarshal(resp.RawResult, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+func (c *Client) GetCloudVolume(id string, queries url.Values) (*CloudVolume, error) {
+	builder := NewRequestBuilder(GET)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/cloud_volumes/"+id, nil, queries)
+	if err != nil {
+		return nil, err
+	}
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	v := &CloudVolume{}
+	if err := json.Unmarshal(resp.RawResult, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+func (c *Client) CreateCloudVolume(ctx context.Context, providerID string, params *CloudVolumeParams) (*ActionResult, error) {
+	if params == nil {
+		return nil, fmt.Errorf("params can't be nil")
+	}
+	body := *params
+	body.EMSID = providerID
+	return c.doCollectionAction(ctx, "/cloud_volumes", "create", &body)
+}
+
+func (c *Client) UpdateCloudVolume(ctx context.Context, id string, params *CloudVolumeParams) (*ActionResult, error) {
+	return c.doAction(ctx, POST, c.Authenticator.GetBaseURL(), "/cloud_volumes/"+id, "edit", params)
+}
+
+func (c *Client) DeleteCloudVolume(ctx context.Context, id string) (*ActionResult, error) {
+	return c.doAction(ctx, POST, c.Authenticator.GetBaseURL(), "/cloud_volumes/"+id, "delete", nil)
+}
+
+// AttachCloudVolume attaches the volume to the vm, device is optional and picked by the provider when empty.
+func (c *Client) AttachCloudVolume(ctx context.Context, id, vmID, device string) (*ActionResult, error) {
+	return c.doAction(ctx, POST, c.Authenticator.GetBaseURL(), "/cloud_volumes/"+id, "attach", &volumeAttachment{VMID: vmID, Device: device})
+}
+
+func (c *Client) DetachCloudVolume(ctx context.Context, id, vmID string) (*ActionResult, error) {
+	return c.doAction(ctx, POST, c.Authenticator.GetBaseURL(), "/cloud_volumes/"+id, "detach", &volumeAttachment{VMID: vmID})
+}
+
+func (c *Client) ListCloudVolumeSnapshots(queries url.Values) (*CloudVolumeSnapshots, error) {
+	builder := NewRequestBuilder(GET)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/cloud_volume_snapshots", nil, queries)
+	if err != nil {
+		return nil, err
+	}
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	s := &CloudVolumeSnapshots{}
+	if err := json.Unmarshal(resp.RawResult, &s); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (c *Client) GetCloudVolumeSnapshot(id string, queries url.Values) (*CloudVolumeSnapshot, error) {
+	builder := NewRequestBuilder(GET)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/cloud_volume_snapshots/"+id, nil, queries)
+	if err != nil {
+		return nil, err
+	}
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	s := &CloudVolumeSnapshot{}
+	if err := json.Unmarshal(resp.RawResult, &s); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (c *Client) CreateCloudVolumeSnapshot(ctx context.Context, params *CloudVolumeSnapshotParams) (*ActionResult, error) {
+	return c.doCollectionAction(ctx, "/cloud_volume_snapshots", "create", params)
+}
+
+func (c *Client) DeleteCloudVolumeSnapshot(ctx context.Context, id string) (*ActionResult, error) {
+	return c.doAction(ctx, POST, c.Authenticator.GetBaseURL(), "/cloud_volume_snapshots/"+id, "delete", nil)
+}
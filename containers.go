@@ -0,0 +1,432 @@
+package manageiq
+
+import (
+	"encoding/json"
+	"net/url"
+	"time"
+)
+
+type ContainerProjects struct {
+	MangeIQListResource
+	Resources []ContainerProject `json:"resources"`
+}
+
+type ContainerProject struct {
+	Href            string    `json:"href"`
+	ID              string    `json:"id"`
+	Name            string    `json:"name"`
+	DisplayName     string    `json:"display_name"`
+	EMSID           string    `json:"ems_id"`
+	EMSRef          string    `json:"ems_ref"`
+	ResourceVersion string    `json:"resource_version"`
+	CreatedOn       time.Time `json:"created_on"`
+	DeletedOn       time.Time `json:"deleted_on"`
+	Actions         []Action  `json:"actions"`
+	// Only populated when requested with the matching attributes, e.g.
+	// Query(WithExpand("resources"), WithAttributes("container_groups"))
+	ContainerGroups      []ContainerGroup      `json:"container_groups"`
+	ContainerDeployments []ContainerDeployment `json:"container_deployments"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (c *ContainerProject) UnmarshalJSON(b []byte) error {
+	type alias ContainerProject
+	extra, err := unmarshalWithExtra(b, (*alias)(c))
+	if err != nil {
+		return err
+	}
+	c.Extra = extra
+	return nil
+}
+
+func (c ContainerProject) MarshalJSON() ([]byte, error) {
+	type alias ContainerProject
+	return marshalWithExtra(alias(c), c.Extra)
+}
+
+type ContainerNodes struct {
+	MangeIQListResource
+	Resources []ContainerNode `json:"resources"`
+}
+
+type ContainerNode struct {
+	Href               string    `json:"href"`
+	ID                 string    `json:"id"`
+	Name               string    `json:"name"`
+	Type               string    `json:"type"`
+	EMSID              string    `json:"ems_id"`
+	EMSRef             string    `json:"ems_ref"`
+	Identity           string    `json:"identity_system"`
+	KubernetesVersion  string    `json:"kubernetes_kubelet_version"`
+	ContainerRuntime   string    `json:"container_runtime_version"`
+	MaxContainerGroups int       `json:"max_container_groups"`
+	AllocatableCPU     float64   `json:"allocatable_cpu"`
+	AllocatableMemory  float64   `json:"allocatable_memory"`
+	AllocatablePods    int       `json:"allocatable_pods"`
+	ResourceVersion    string    `json:"resource_version"`
+	CreatedOn          time.Time `json:"created_on"`
+	DeletedOn          time.Time `json:"deleted_on"`
+	Actions            []Action  `json:"actions"`
+	// Only populated when requested with the matching attributes
+	ContainerGroups []ContainerGroup  `json:"container_groups"`
+	Labels          []CustomAttribute `json:"labels"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (c *ContainerNode) UnmarshalJSON(b []byte) error {
+	type alias ContainerNode
+	extra, err := unmarshalWithExtra(b, (*alias)(c))
+	if err != nil {
+		return err
+	}
+	c.Extra = extra
+	return nil
+}
+
+func (c ContainerNode) MarshalJSON() ([]byte, error) {
+	type alias ContainerNode
+	return marshalWithExtra(alias(c), c.Extra)
+}
+
+type ContainerGroups struct {
+	MangeIQListResource
+	Resources []ContainerGroup `json:"resources"`
+}
+
+// ContainerGroup is a pod.
+type ContainerGroup struct {
+	Href               string    `json:"href"`
+	ID                 string    `json:"id"`
+	Name               string    `json:"name"`
+	Type               string    `json:"type"`
+	EMSID              string    `json:"ems_id"`
+	EMSRef             string    `json:"ems_ref"`
+	Phase              string    `json:"phase"`
+	Message            string    `json:"message"`
+	Reason             string    `json:"reason"`
+	RestartPolicy      string    `json:"restart_policy"`
+	DNSPolicy          string    `json:"dns_policy"`
+	IPAddress          string    `json:"ipaddress"`
+	ContainerNodeID    string    `json:"container_node_id"`
+	ContainerProjectID string    `json:"container_project_id"`
+	ResourceVersion    string    `json:"resource_version"`
+	CreatedOn          time.Time `json:"created_on"`
+	DeletedOn          time.Time `json:"deleted_on"`
+	Actions            []Action  `json:"actions"`
+	// Only populated when requested with the matching attributes
+	ContainerImages []ContainerImage  `json:"container_images"`
+	Labels          []CustomAttribute `json:"labels"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (c *ContainerGroup) UnmarshalJSON(b []byte) error {
+	type alias ContainerGroup
+	extra, err := unmarshalWithExtra(b, (*alias)(c))
+	if err != nil {
+		return err
+	}
+	c.Extra = extra
+	return nil
+}
+
+func (c ContainerGroup) MarshalJSON() ([]byte, error) {
+	type alias ContainerGroup
+	return marshalWithExtra(alias(c), c.Extra)
+}
+
+type ContainerImages struct {
+	MangeIQListResource
+	Resources []ContainerImage `json:"resources"`
+}
+
+type ContainerImage struct {
+	Href                     string    `json:"href"`
+	ID                       string    `json:"id"`
+	Name                     string    `json:"name"`
+	Tag                      string    `json:"tag"`
+	Digest                   string    `json:"digest"`
+	ImageRef                 string    `json:"image_ref"`
+	Architecture             string    `json:"architecture"`
+	OperatingSystem          string    `json:"os"`
+	Size                     int64     `json:"size"`
+	EMSID                    string    `json:"ems_id"`
+	ContainerImageRegistryID string    `json:"container_image_registry_id"`
+	RegisteredOn             time.Time `json:"registered_on"`
+	CreatedOn                time.Time `json:"created_on"`
+	DeletedOn                time.Time `json:"deleted_on"`
+	Actions                  []Action  `json:"actions"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (c *ContainerImage) UnmarshalJSON(b []byte) error {
+	type alias ContainerImage
+	extra, err := unmarshalWithExtra(b, (*alias)(c))
+	if err != nil {
+		return err
+	}
+	c.Extra = extra
+	return nil
+}
+
+func (c ContainerImage) MarshalJSON() ([]byte, error) {
+	type alias ContainerImage
+	return marshalWithExtra(alias(c), c.Extra)
+}
+
+type ContainerDeployments struct {
+	MangeIQListResource
+	Resources []ContainerDeployment `json:"resources"`
+}
+
+type ContainerDeployment struct {
+	Href               string    `json:"href"`
+	ID                 string    `json:"id"`
+	Name               string    `json:"name"`
+	EMSRef             string    `json:"ems_ref"`
+	Phase              string    `json:"phase"`
+	Replicas           int       `json:"replicas"`
+	ContainerProjectID string    `json:"container_project_id"`
+	CreatedOn          time.Time `json:"created_on"`
+	Actions            []Action  `json:"actions"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (c *ContainerDeployment) UnmarshalJSON(b []byte) error {
+	type alias ContainerDeployment
+	extra, err := unmarshalWithExtra(b, (*alias)(c))
+	if err != nil {
+		return err
+	}
+	c.Extra = extra
+	return nil
+}
+
+func (c ContainerDeployment) MarshalJSON() ([]byte, error) {
+	type alias ContainerDeployment
+	return marshalWithExtra(alias(c), c.Extra)
+}
+
+func (c *Client) ListContainerProjects(queries url.Values) (*ContainerProjects, error) {
+	builder := NewRequestBuilder(GET)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/container_projects", nil, queries)
+	if err != nil {
+		return nil, err
+	}
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	p := &ContainerProjects{}
+	if err := json.Unmarshal(resp.RawResult, &p); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+func (c *Client) GetContainerProject(id string, queries url.Values) (*ContainerProject, error) {
+	builder := NewRequestBuilder(GET)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/container_projects/"+id, nil, queries)
+	if err != nil {
+		return nil, err
+	}
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	p := &ContainerProject{}
+	if err := json.Unmarshal(resp.RawResult, &p); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+func (c *Client) ListContainerNodes(queries url.Values) (*ContainerNodes, error) {
+	builder := NewRequestBuilder(GET)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/container_nodes", nil, queries)
+	if err != nil {
+		return nil, err
+	}
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	n := &ContainerNodes{}
+	if err := json.Unmarshal(resp.RawResult, &n); err != nil {
+		return nil, err
+	}
+	return n, nil
+}
+
+func (c *Client) GetContainerNode(id string, queries url.Values) (*ContainerNode, error) {
+	builder := NewRequestBuilder(GET)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/container_nodes/"+id, nil, queries)
+	if err != nil {
+		return nil, err
+	}
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	n := &ContainerNode{}
+	if err := json.Unmarshal(resp.RawResult, &n); err != nil {
+		return nil, err
+	}
+	return n, nil
+}
+
+func (c *Client) ListContainerGroups(queries url.Values) (*ContainerGroups, error) {
+	builder := NewRequestBuilder(GET)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/container_groups", nil, queries)
+	if err != nil {
+		return nil, err
+	}
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	g := &ContainerGroups{}
+	if err := json.Unmarshal(resp.RawResult, &g); err != nil {
+		return nil, err
+	}
+	return g, nil
+}
+
+func (c *Client) GetContainerGroup(id string, queries url.Values) (*ContainerGroup, error) {
+	builder := NewRequestBuilder(GET)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/container_groups/"+id, nil, queries)
+	if err != nil {
+		return nil, err
+	}
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	g := &ContainerGroup{}
+	if err := json.Unmarshal(resp.RawResult, &g); err != nil {
+		return nil, err
+	}
+	return g, nil
+}
+
+func (c *Client) ListContainerImages(queries url.Values) (*ContainerImages, error) {
+	builder := NewRequestBuilder(GET)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/container_images", nil, queries)
+	if err != nil {
+		return nil, err
+	}
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	i := &ContainerImages{}
+	if err := json.Unmarshal(resp.RawResult, &i); err != nil {
+		return nil, err
+	}
+	return i, nil
+}
+
+func (c *Client) GetContainerImage(id string, queries url.Values) (*ContainerImage, error) {
+	builder := NewRequestBuilder(GET)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/container_images/"+id, nil, queries)
+	if err != nil {
+		return nil, err
+	}
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	i := &ContainerImage{}
+	if err := json.Unmarshal(resp.RawResult, &i); err != nil {
+		return nil, err
+	}
+	return i, nil
+}
+
+func (c *Client) ListContainerDeployments(queries url.Values) (*ContainerDeployments, error) {
+	builder := NewRequestBuilder(GET)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/container_deployments", nil, queries)
+	if err != nil {
+		return nil, err
+	}
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	d := &ContainerDeployments{}
+	if err := json.Unmarshal(resp.RawResult, &d); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+func (c *Client) GetContainerDeployment(id string, queries url.Values) (*ContainerDeployment, error) {
+	builder := NewRequestBuilder(GET)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/container_deployments/"+id, nil, queries)
+	if err != nil {
+		return nil, err
+	}
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	d := &ContainerDeployment{}
+	if err := json.Unmarshal(resp.RawResult, &d); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
@@ -0,0 +1,369 @@
+package manageiq
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+const (
+	CredentialTypeMachine   = "ManageIQ::Providers::EmbeddedAnsible::AutomationManager::MachineCredential"
+	CredentialTypeSCM       = "ManageIQ::Providers::EmbeddedAnsible::AutomationManager::ScmCredential"
+	CredentialTypeVault     = "ManageIQ::Providers::EmbeddedAnsible::AutomationManager::VaultCredential"
+	CredentialTypeAmazon    = "ManageIQ::Providers::EmbeddedAnsible::AutomationManager::AmazonCredential"
+	CredentialTypeAzure     = "ManageIQ::Providers::EmbeddedAnsible::AutomationManager::AzureCredential"
+	CredentialTypeGoogle    = "ManageIQ::Providers::EmbeddedAnsible::AutomationManager::GoogleCredential"
+	CredentialTypeOpenstack = "ManageIQ::Providers::EmbeddedAnsible::AutomationManager::OpenstackCredential"
+
+	redacted = "REDACTED"
+)
+
+// Secret holds a write-only value, it is sent to ManageIQ but never printed or decoded from a response.
+type Secret string
+
+func (s Secret) String() string {
+	return redacted
+}
+
+func (s Secret) GoString() string {
+	return redacted
+}
+
+func (s Secret) MarshalJSON() ([]byte, error) {
+	return json.Marshal(string(s))
+}
+
+func (s *Secret) UnmarshalJSON([]byte) error {
+	return nil
+}
+
+type ConfigurationScriptSources struct {
+	MangeIQListResource
+	Resources []ConfigurationScriptSource `json:"resources"`
+}
+
+type ConfigurationScriptSource struct {
+	Href             string    `json:"href"`
+	ID               string    `json:"id"`
+	Name             string    `json:"name"`
+	Description      string    `json:"description"`
+	Type             string    `json:"type"`
+	SCMType          string    `json:"scm_type"`
+	SCMURL           string    `json:"scm_url"`
+	SCMBranch        string    `json:"scm_branch"`
+	Status           string    `json:"status"`
+	LastUpdatedOn    time.Time `json:"last_updated_on"`
+	LastUpdateError  string    `json:"last_update_error"`
+	ManagerID        string    `json:"manager_id"`
+	AuthenticationID string    `json:"authentication_id"`
+	VerifySSL        bool      `json:"verify_ssl"`
+	CreatedAt        time.Time `json:"created_at"`
+	UpdatedAt        time.Time `json:"updated_at"`
+	Actions          []Action  `json:"actions"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (c *ConfigurationScriptSource) UnmarshalJSON(b []byte) error {
+	type alias ConfigurationScriptSource
+	extra, err := unmarshalWithExtra(b, (*alias)(c))
+	if err != nil {
+		return err
+	}
+	c.Extra = extra
+	return nil
+}
+
+func (c ConfigurationScriptSource) MarshalJSON() ([]byte, error) {
+	type alias ConfigurationScriptSource
+	return marshalWithExtra(alias(c), c.Extra)
+}
+
+type ConfigurationScriptSourceParams struct {
+	Name             string       `json:"name,omitempty"`
+	Description      string       `json:"description,omitempty"`
+	SCMType          string       `json:"scm_type,omitempty"`
+	SCMURL           string       `json:"scm_url,omitempty"`
+	SCMBranch        string       `json:"scm_branch,omitempty"`
+	AuthenticationID string       `json:"authentication_id,omitempty"`
+	VerifySSL        *bool        `json:"verify_ssl,omitempty"`
+	ManagerResource  *ResourceRef `json:"manager_resource,omitempty"`
+}
+
+type ConfigurationScriptPayloads struct {
+	MangeIQListResource
+	Resources []ConfigurationScriptPayload `json:"resources"`
+}
+
+// ConfigurationScriptPayload is a playbook of a configuration script source.
+type ConfigurationScriptPayload struct {
+	Href                        string    `json:"href"`
+	ID                          string    `json:"id"`
+	Name                        string    `json:"name"`
+	Description                 string    `json:"description"`
+	Type                        string    `json:"type"`
+	ManagerID                   string    `json:"manager_id"`
+	ConfigurationScriptSourceID string    `json:"configuration_script_source_id"`
+	Payload                     string    `json:"payload"`
+	PayloadType                 string    `json:"payload_type"`
+	CreatedAt                   time.Time `json:"created_at"`
+	UpdatedAt                   time.Time `json:"updated_at"`
+	Actions                     []Action  `json:"actions"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (c *ConfigurationScriptPayload) UnmarshalJSON(b []byte) error {
+	type alias ConfigurationScriptPayload
+	extra, err := unmarshalWithExtra(b, (*alias)(c))
+	if err != nil {
+		return err
+	}
+	c.Extra = extra
+	return nil
+}
+
+func (c ConfigurationScriptPayload) MarshalJSON() ([]byte, error) {
+	type alias ConfigurationScriptPayload
+	return marshalWithExtra(alias(c), c.Extra)
+}
+
+type Authentications struct {
+	MangeIQListResource
+	Resources []Authentication `json:"resources"`
+}
+
+// Authentication is a credential as returned by ManageIQ, secret fields are never returned.
+type Authentication struct {
+	Href         string                 `json:"href"`
+	ID           string                 `json:"id"`
+	Name         string                 `json:"name"`
+	Type         string                 `json:"type"`
+	AuthType     string                 `json:"authtype"`
+	UserID       string                 `json:"userid"`
+	ManagerRef   string                 `json:"manager_ref"`
+	ResourceID   string                 `json:"resource_id"`
+	ResourceType string                 `json:"resource_type"`
+	Status       string                 `json:"status"`
+	Options      map[string]interface{} `json:"options"`
+	CreatedOn    time.Time              `json:"created_on"`
+	UpdatedOn    time.Time              `json:"updated_on"`
+	Actions      []Action               `json:"actions"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (a *Authentication) UnmarshalJSON(b []byte) error {
+	type alias Authentication
+	extra, err := unmarshalWithExtra(b, (*alias)(a))
+	if err != nil {
+		return err
+	}
+	a.Extra = extra
+	return nil
+}
+
+func (a Authentication) MarshalJSON() ([]byte, error) {
+	type alias Authentication
+	return marshalWithExtra(alias(a), a.Extra)
+}
+
+type AuthenticationParams struct {
+	Name            string       `json:"name,omitempty"`
+	Type            string       `json:"type,omitempty"`
+	UserID          string       `json:"userid,omitempty"`
+	Password        Secret       `json:"password,omitempty"`
+	SSHKeyData      Secret       `json:"ssh_key_data,omitempty"`
+	SSHKeyUnlock    Secret       `json:"ssh_key_unlock,omitempty"`
+	BecomeMethod    string       `json:"become_method,omitempty"`
+	BecomeUsername  string       `json:"become_username,omitempty"`
+	BecomePassword  Secret       `json:"become_password,omitempty"`
+	VaultPassword   Secret       `json:"vault_password,omitempty"`
+	Host            string       `json:"host,omitempty"`
+	Project         string       `json:"project,omitempty"`
+	Domain          string       `json:"domain,omitempty"`
+	Subscription    string       `json:"subscription,omitempty"`
+	Tenant          string       `json:"tenant,omitempty"`
+	Secret          Secret       `json:"secret,omitempty"`
+	ManagerResource *ResourceRef `json:"manager_resource,omitempty"`
+}
+
+func (c *Client) ListConfigurationScriptSources(queries url.Values) (*ConfigurationScriptSources, error) {
+	builder := NewRequestBuilder(GET)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/configuration_script_sources", nil, queries)
+	if err != nil {
+		return nil, err
+	}
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	s := &ConfigurationScriptSources{}
+	if err := json.Unmarshal(resp.RawResult, &s); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (c *Client) GetConfigurationScriptSource(id string, queries url.Values) (*ConfigurationScriptSource, error) {
+	builder := NewRequestBuilder(GET)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/configuration_script_sources/"+id, nil, queries)
+	if err != nil {
+		return nil, err
+	}
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	s := &ConfigurationScriptSource{}
+	if err := json.Unmarshal(resp.RawResult, &s); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// CreateConfigurationScriptSource creates the repository, the creation is processed by the task returned.
+func (c *Client) CreateConfigurationScriptSource(ctx context.Context, params *ConfigurationScriptSourceParams) (*ActionResult, error) {
+	if params == nil {
+		return nil, fmt.Errorf("params can't be nil")
+	}
+	return c.doCollectionAction(ctx, "/configuration_script_sources", "create", params)
+}
+
+func (c *Client) UpdateConfigurationScriptSource(ctx context.Context, id string, params *ConfigurationScriptSourceParams) (*ActionResult, error) {
+	if params == nil {
+		return nil, fmt.Errorf("params can't be nil")
+	}
+	return c.doAction(ctx, POST, c.Authenticator.GetBaseURL(), "/configuration_script_sources/"+id, "edit", params)
+}
+
+// RefreshConfigurationScriptSource syncs the playbooks of the repository from its SCM.
+func (c *Client) RefreshConfigurationScriptSource(ctx context.Context, id string) (*ActionResult, error) {
+	return c.doAction(ctx, POST, c.Authenticator.GetBaseURL(), "/configuration_script_sources/"+id, "refresh", nil)
+}
+
+func (c *Client) DeleteConfigurationScriptSource(ctx context.Context, id string) (*ActionResult, error) {
+	return c.doAction(ctx, POST, c.Authenticator.GetBaseURL(), "/configuration_script_sources/"+id, "delete", nil)
+}
+
+func (c *Client) ListConfigurationScriptPayloads(queries url.Values) (*ConfigurationScriptPayloads, error) {
+	builder := NewRequestBuilder(GET)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/configuration_script_payloads", nil, queries)
+	if err != nil {
+		return nil, err
+	}
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	p := &ConfigurationScriptPayloads{}
+	if err := json.Unmarshal(resp.RawResult, &p); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+func (c *Client) GetConfigurationScriptPayload(id string, queries url.Values) (*ConfigurationScriptPayload, error) {
+	builder := NewRequestBuilder(GET)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/configuration_script_payloads/"+id, nil, queries)
+	if err != nil {
+		return nil, err
+	}
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	p := &ConfigurationScriptPayload{}
+	if err := json.Unmarshal(resp.RawResult, &p); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+func (c *Client) ListAuthentications(queries url.Values) (*Authentications, error) {
+	builder := NewRequestBuilder(GET)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/authentications", nil, queries)
+	if err != nil {
+		return nil, err
+	}
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	a := &Authentications{}
+	if err := json.Unmarshal(resp.RawResult, &a); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+func (c *Client) GetAuthentication(id string, queries url.Values) (*Authentication, error) {
+	builder := NewRequestBuilder(GET)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/authentications/"+id, nil, queries)
+	if err != nil {
+		return nil, err
+	}
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	a := &Authentication{}
+	if err := json.Unmarshal(resp.RawResult, &a); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// CreateAuthentication creates the credential, the creation is processed by the task returned.
+func (c *Client) CreateAuthentication(ctx context.Context, params *AuthenticationParams) (*ActionResult, error) {
+	if params == nil {
+		return nil, fmt.Errorf("params can't be nil")
+	}
+	if params.Type == "" {
+		return nil, fmt.Errorf("credential type can't be empty")
+	}
+	return c.doCollectionAction(ctx, "/authentications", "create", params)
+}
+
+func (c *Client) UpdateAuthentication(ctx context.Context, id string, params *AuthenticationParams) (*ActionResult, error) {
+	if params == nil {
+		return nil, fmt.Errorf("params can't be nil")
+	}
+	return c.doAction(ctx, POST, c.Authenticator.GetBaseURL(), "/authentications/"+id, "edit", params)
+}
+
+func (c *Client) DeleteAuthentication(ctx context.Context, id string) (*ActionResult, error) {
+	return c.doAction(ctx, POST, c.Authenticator.GetBaseURL(), "/authentications/"+id, "delete", nil)
+}
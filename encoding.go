@@ -0,0 +1,266 @@
+package manageiq
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"time"
+)
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// timeFormats are the layouts of the timestamps returned by ManageIQ, dates like retires_on are
+// returned without the time part.
+var timeFormats = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04:05 MST",
+	"2006-01-02 15:04:05 -0700",
+	"2006-01-02 15:04:05",
+	"2006-01-02",
+}
+
+// unmarshalWithExtra decodes b into v and returns the attributes of b which aren't mapped by any
+// field of v. Numeric values of string fields, like the ids of the nested resources, are decoded
+// as strings and timestamps are accepted in any of the timeFormats.
+func unmarshalWithExtra(b []byte, v interface{}) (map[string]json.RawMessage, error) {
+	all := map[string]json.RawMessage{}
+	if err := json.Unmarshal(b, &all); err != nil {
+		return nil, err
+	}
+	fields := jsonFields(reflect.TypeOf(v))
+	normalized := false
+	for name, t := range fields {
+		raw, ok := all[name]
+		if !ok {
+			continue
+		}
+		if n, ok := normalizeValue(t, raw); ok {
+			all[name] = n
+			normalized = true
+		}
+	}
+	if normalized {
+		var err error
+		if b, err = json.Marshal(all); err != nil {
+			return nil, err
+		}
+	}
+	if err := json.Unmarshal(b, v); err != nil {
+		return nil, err
+	}
+
+	for name := range fields {
+		delete(all, name)
+	}
+	if len(all) == 0 {
+		return nil, nil
+	}
+	return all, nil
+}
+
+// normalizeValue converts the raw value to the representation expected by the field type, false is
+// returned when the value doesn't need to be converted.
+func normalizeValue(t reflect.Type, raw json.RawMessage) (json.RawMessage, bool) {
+	if string(raw) == "null" {
+		return nil, false
+	}
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	switch {
+	case t.Kind() == reflect.String:
+		var n json.Number
+		if len(raw) > 0 && raw[0] != '"' && json.Unmarshal(raw, &n) == nil {
+			quoted, _ := json.Marshal(n.String())
+			return quoted, true
+		}
+	case isNumberKind(t.Kind()):
+		// Numbers like counts and sequences can be returned as strings.
+		var s string
+		if json.Unmarshal(raw, &s) != nil {
+			break
+		}
+		if s == "" {
+			return json.RawMessage("null"), true
+		}
+		var n json.Number
+		if json.Unmarshal([]byte(s), &n) == nil {
+			return json.RawMessage(n.String()), true
+		}
+	case t == timeType:
+		var str string
+		if json.Unmarshal(raw, &str) != nil {
+			break
+		}
+		if str == "" {
+			return json.RawMessage("null"), true
+		}
+		if ts, ok := parseTime(str); ok {
+			quoted, _ := json.Marshal(ts.Format(time.RFC3339Nano))
+			return quoted, true
+		}
+	}
+	return nil, false
+}
+
+// parseTime parses the timestamp in any of the formats used by ManageIQ, timestamps without a
+// zone are in UTC.
+func parseTime(s string) (time.Time, bool) {
+	for _, layout := range timeFormats {
+		if ts, err := time.Parse(layout, s); err == nil {
+			return ts, true
+		}
+	}
+	return time.Time{}, false
+}
+
+func isNumberKind(k reflect.Kind) bool {
+	switch k {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
+
+// marshalWithExtra marshals v and merges the extra values into the resulting object, the fields of v
+// take precedence over the extra values with the same key. Zero timestamps and nil relationships are
+// left out, so that only the attributes of the resource are sent back.
+func marshalWithExtra[V any](v interface{}, extra map[string]V) ([]byte, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	unset := unsetFields(v)
+	if len(extra) == 0 && len(unset) == 0 {
+		return b, nil
+	}
+	typed := map[string]json.RawMessage{}
+	if err := json.Unmarshal(b, &typed); err != nil {
+		return nil, err
+	}
+	for _, name := range unset {
+		delete(typed, name)
+	}
+	merged := make(map[string]interface{}, len(extra)+len(typed))
+	for k, v := range extra {
+		merged[k] = v
+	}
+	for k, v := range typed {
+		merged[k] = v
+	}
+	return json.Marshal(merged)
+}
+
+// jsonFields returns the types of the fields of the struct type t keyed by the name of the json
+// attribute they map, including the fields of the embedded structs.
+func jsonFields(t reflect.Type) map[string]reflect.Type {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	fields := map[string]reflect.Type{}
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag := f.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name := strings.Split(tag, ",")[0]
+		if f.Anonymous && name == "" {
+			for n, ft := range jsonFields(f.Type) {
+				if _, ok := fields[n]; !ok {
+					fields[n] = ft
+				}
+			}
+			continue
+		}
+		if !f.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		fields[name] = f.Type
+	}
+	return fields
+}
+
+// unsetFields returns the json names of the fields of v which aren't set, the zero timestamps and the
+// nil pointers, slices and maps. The fields of the embedded structs are unset when they hold their zero
+// value, e.g. the counts of a MangeIQListResource embedded by a resource.
+func unsetFields(v interface{}) []string {
+	rv := reflect.Indirect(reflect.ValueOf(v))
+	if rv.Kind() != reflect.Struct {
+		return nil
+	}
+	var names, embedded []string
+	for i := 0; i < rv.NumField(); i++ {
+		f := rv.Type().Field(i)
+		fv := rv.Field(i)
+		if f.Anonymous {
+			if f.Type.Kind() == reflect.Struct {
+				embedded = append(embedded, zeroFields(fv)...)
+			}
+			continue
+		}
+		if !f.IsExported() {
+			continue
+		}
+		switch {
+		case f.Type == timeType:
+			if !fv.Interface().(time.Time).IsZero() {
+				continue
+			}
+		case f.Type.Kind() == reflect.Ptr, f.Type.Kind() == reflect.Slice, f.Type.Kind() == reflect.Map, f.Type.Kind() == reflect.Interface:
+			if !fv.IsNil() {
+				continue
+			}
+		default:
+			continue
+		}
+		if name := strings.Split(f.Tag.Get("json"), ",")[0]; name != "" && name != "-" {
+			names = append(names, name)
+		}
+	}
+	// The fields of the struct hide the fields of the embedded structs mapping the same attribute.
+	for _, name := range embedded {
+		if !isOwnField(rv.Type(), name) {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// isOwnField returns true if the attribute is mapped by a field of the struct type t itself rather
+// than by a field of an embedded struct.
+func isOwnField(t reflect.Type, name string) bool {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.Anonymous && strings.Split(f.Tag.Get("json"), ",")[0] == name {
+			return true
+		}
+	}
+	return false
+}
+
+// zeroFields returns the json names of the fields of the struct rv holding their zero value.
+func zeroFields(rv reflect.Value) []string {
+	var names []string
+	for i := 0; i < rv.NumField(); i++ {
+		f := rv.Type().Field(i)
+		fv := rv.Field(i)
+		if f.Anonymous && f.Type.Kind() == reflect.Struct {
+			names = append(names, zeroFields(fv)...)
+			continue
+		}
+		if !f.IsExported() || !fv.IsZero() {
+			continue
+		}
+		if name := strings.Split(f.Tag.Get("json"), ",")[0]; name != "" && name != "-" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
@@ -0,0 +1,270 @@
+package manageiq
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+)
+
+type encodingTestModel struct {
+	ID        string     `json:"id"`
+	Name      string     `json:"name,omitempty"`
+	Count     int        `json:"count"`
+	Ratio     *float64   `json:"ratio"`
+	CreatedOn time.Time  `json:"created_on"`
+	Tags      []Tag      `json:"tags"`
+	Picture   *Picture   `json:"picture"`
+	Links     Links      `json:"links"`
+	Nested    *Resource  `json:"nested"`
+	Ignored   string     `json:"-"`
+	DeletedOn *time.Time `json:"deleted_on"`
+}
+
+func TestUnmarshalWithExtra(t *testing.T) {
+	ratio := 0.5
+	tests := []struct {
+		name      string
+		input     string
+		want      encodingTestModel
+		wantExtra map[string]json.RawMessage
+		wantErr   bool
+	}{
+		{
+			name:  "mapped fields",
+			input: `{"id": "1", "name": "foo", "count": 2, "ratio": 0.5, "created_on": "2024-01-02T03:04:05Z"}`,
+			want:  encodingTestModel{ID: "1", Name: "foo", Count: 2, Ratio: &ratio, CreatedOn: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)},
+		},
+		{
+			name:      "unmapped attributes",
+			input:     `{"id": "1", "owner": {"id": 3}, "ignored": "x", "retired": false}`,
+			want:      encodingTestModel{ID: "1"},
+			wantExtra: map[string]json.RawMessage{"owner": json.RawMessage(`{"id": 3}`), "ignored": json.RawMessage(`"x"`), "retired": json.RawMessage(`false`)},
+		},
+		{
+			name:  "numeric ids",
+			input: `{"id": 12, "nested": {"id": 10000000000001, "href": "/api/vms/1"}}`,
+			want:  encodingTestModel{ID: "12", Nested: &Resource{ID: "10000000000001", Href: "/api/vms/1"}},
+		},
+		{
+			name:  "numbers as strings",
+			input: `{"id": "1", "count": "3", "ratio": "0.5"}`,
+			want:  encodingTestModel{ID: "1", Count: 3, Ratio: &ratio},
+		},
+		{
+			name:  "empty values",
+			input: `{"id": null, "count": "", "ratio": null, "created_on": "", "deleted_on": ""}`,
+			want:  encodingTestModel{},
+		},
+		{
+			name:  "null timestamp",
+			input: `{"id": "1", "created_on": null}`,
+			want:  encodingTestModel{ID: "1"},
+		},
+		{
+			name:    "invalid timestamp",
+			input:   `{"id": "1", "created_on": "yesterday"}`,
+			wantErr: true,
+		},
+		{
+			name:    "not an object",
+			input:   `[1, 2]`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := encodingTestModel{}
+			extra, err := unmarshalWithExtra([]byte(tt.input), &got)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected an error, got %+v", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("expected %+v, got %+v", tt.want, got)
+			}
+			if len(extra) != len(tt.wantExtra) {
+				t.Fatalf("expected extra %s, got %s", tt.wantExtra, extra)
+			}
+			for k, v := range tt.wantExtra {
+				if string(extra[k]) != string(v) {
+					t.Errorf("expected extra %s to be %s, got %s", k, v, extra[k])
+				}
+			}
+		})
+	}
+}
+
+func TestNormalizeValue(t *testing.T) {
+	stringType := reflect.TypeOf("")
+	intType := reflect.TypeOf(0)
+	floatPtrType := reflect.TypeOf((*float64)(nil))
+	tests := []struct {
+		name    string
+		typ     reflect.Type
+		raw     string
+		want    string
+		changed bool
+	}{
+		{name: "string", typ: stringType, raw: `"1"`},
+		{name: "number to string", typ: stringType, raw: `12`, want: `"12"`, changed: true},
+		{name: "null string", typ: stringType, raw: `null`},
+		{name: "object to string", typ: stringType, raw: `{}`},
+		{name: "int", typ: intType, raw: `3`},
+		{name: "string to int", typ: intType, raw: `"3"`, want: `3`, changed: true},
+		{name: "empty string to int", typ: intType, raw: `""`, want: `null`, changed: true},
+		{name: "invalid int", typ: intType, raw: `"three"`},
+		{name: "string to float pointer", typ: floatPtrType, raw: `"1.5"`, want: `1.5`, changed: true},
+		{name: "empty timestamp", typ: timeType, raw: `""`, want: `null`, changed: true},
+		{name: "null timestamp", typ: timeType, raw: `null`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, changed := normalizeValue(tt.typ, json.RawMessage(tt.raw))
+			if changed != tt.changed {
+				t.Fatalf("expected changed %v, got %v", tt.changed, changed)
+			}
+			if changed && string(got) != tt.want {
+				t.Errorf("expected %s, got %s", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestMarshalWithExtra(t *testing.T) {
+	tests := []struct {
+		name  string
+		value encodingTestModel
+		extra map[string]json.RawMessage
+		want  string
+	}{
+		{
+			name:  "unset fields left out",
+			value: encodingTestModel{ID: "1"},
+			want:  `{"count":0,"id":"1","links":{"self":"","first":"","last":""}}`,
+		},
+		{
+			name: "set fields",
+			value: encodingTestModel{
+				ID:        "1",
+				Name:      "foo",
+				CreatedOn: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+				Tags:      []Tag{{Name: "/managed/env/prod"}},
+			},
+			want: `{"count":0,"created_on":"2024-01-02T03:04:05Z","id":"1","links":{"self":"","first":"","last":""},"name":"foo","tags":[{"name":"/managed/env/prod"}]}`,
+		},
+		{
+			name:  "extra merged",
+			value: encodingTestModel{ID: "1"},
+			extra: map[string]json.RawMessage{"owner": json.RawMessage(`{"id":3}`), "id": json.RawMessage(`"2"`)},
+			want:  `{"count":0,"id":"1","links":{"self":"","first":"","last":""},"owner":{"id":3}}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := marshalWithExtra(tt.value, tt.extra)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("expected %s, got %s", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestServiceRoundTrip(t *testing.T) {
+	input := `{
+		"href": "https://miq/api/services/10",
+		"id": 10,
+		"name": "web",
+		"description": "web tier",
+		"created_at": "2024-01-02T03:04:05Z",
+		"updated_at": "2024-01-03T03:04:05Z",
+		"retired": false,
+		"retires_on": null,
+		"lifecycle_state": "provisioned",
+		"service_template_id": "4",
+		"options": {"dialog": {"dialog_size": "large"}},
+		"actions": [{"name": "edit", "method": "post", "href": "https://miq/api/services/10"}]
+	}`
+	s := &Service{}
+	if err := json.Unmarshal([]byte(input), s); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if s.ID != "10" || s.Name != "web" || len(s.GetActions()) != 1 {
+		t.Fatalf("unexpected service %+v", s)
+	}
+	s.Description = "web and api tier"
+	s.Actions = nil
+
+	got, err := json.Marshal(s)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := `{"created_at":"2024-01-02T03:04:05Z","description":"web and api tier","href":"https://miq/api/services/10","id":"10","lifecycle_state":"provisioned","name":"web","options":{"dialog":{"dialog_size":"large"}},"retired":false,"service_template_id":"4","updated_at":"2024-01-03T03:04:05Z"}`
+	if string(got) != want {
+		t.Errorf("expected %s, got %s", want, got)
+	}
+}
+
+func TestParseTime(t *testing.T) {
+	tests := []struct {
+		input string
+		want  time.Time
+		ok    bool
+	}{
+		{input: "2024-01-02T03:04:05Z", want: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC), ok: true},
+		{input: "2024-01-02T03:04:05.123Z", want: time.Date(2024, 1, 2, 3, 4, 5, 123000000, time.UTC), ok: true},
+		{input: "2024-01-02T03:04:05+02:00", want: time.Date(2024, 1, 2, 1, 4, 5, 0, time.UTC), ok: true},
+		{input: "2024-01-02T03:04:05", want: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC), ok: true},
+		{input: "2024-01-02 03:04:05 UTC", want: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC), ok: true},
+		{input: "2024-01-02 03:04:05 +0200", want: time.Date(2024, 1, 2, 1, 4, 5, 0, time.UTC), ok: true},
+		{input: "2024-01-02 03:04:05", want: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC), ok: true},
+		{input: "2024-01-02", want: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC), ok: true},
+		{input: ""},
+		{input: "01/02/2024"},
+		{input: "tomorrow"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, ok := parseTime(tt.input)
+			if ok != tt.ok {
+				t.Fatalf("expected ok %v, got %v", tt.ok, ok)
+			}
+			if ok && !got.Equal(tt.want) {
+				t.Errorf("expected %s, got %s", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestUnmarshalTimestampFormats(t *testing.T) {
+	tests := []struct {
+		input string
+		want  time.Time
+	}{
+		{input: `{"retires_on": "2024-05-01"}`, want: time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)},
+		{input: `{"retires_on": "2024-05-01 10:00:00 UTC"}`, want: time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)},
+		{input: `{"retires_on": "2024-05-01T10:00:00Z"}`, want: time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)},
+		{input: `{"retires_on": null}`},
+		{input: `{"retires_on": ""}`},
+		{input: `{}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			s := &Service{}
+			if err := json.Unmarshal([]byte(tt.input), s); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !s.RetiresOn.Equal(tt.want) {
+				t.Errorf("expected %s, got %s", tt.want, s.RetiresOn)
+			}
+		})
+	}
+}
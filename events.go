@@ -0,0 +1,333 @@
+package manageiq
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+const (
+	defaultEventPollInterval = 10 * time.Second
+	eventPageSize            = 100
+)
+
+type EventStreams struct {
+	MangeIQListResource
+	Resources []EventStream `json:"resources"`
+}
+
+type EventStream struct {
+	Href           string                 `json:"href"`
+	ID             string                 `json:"id"`
+	Type           string                 `json:"type"`
+	EventType      string                 `json:"event_type"`
+	Message        string                 `json:"message"`
+	Source         string                 `json:"source"`
+	Timestamp      time.Time              `json:"timestamp"`
+	TargetID       string                 `json:"target_id"`
+	TargetType     string                 `json:"target_type"`
+	VMOrTemplateID string                 `json:"vm_or_template_id"`
+	VMName         string                 `json:"vm_name"`
+	HostID         string                 `json:"host_id"`
+	HostName       string                 `json:"host_name"`
+	EMSID          string                 `json:"ems_id"`
+	UserName       string                 `json:"username"`
+	Group          string                 `json:"group"`
+	GroupLevel     string                 `json:"group_level"`
+	FullData       map[string]interface{} `json:"full_data"`
+	CreatedOn      time.Time              `json:"created_on"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (e *EventStream) UnmarshalJSON(b []byte) error {
+	type alias EventStream
+	extra, err := unmarshalWithExtra(b, (*alias)(e))
+	if err != nil {
+		return err
+	}
+	e.Extra = extra
+	return nil
+}
+
+func (e EventStream) MarshalJSON() ([]byte, error) {
+	type alias EventStream
+	return marshalWithExtra(alias(e), e.Extra)
+}
+
+// EventFilter narrows the events returned by ListEventStreams.
+type EventFilter struct {
+	// Only return the events raised after Since and before Until
+	Since time.Time
+	Until time.Time
+	// Only return the events of the target, e.g. TargetType Vm with the id of the vm
+	TargetType string
+	TargetID   string
+	EventType  string
+}
+
+// queries returns the queries merged with the filter[] entries of the filter, the events are sorted
+// by id unless the queries sort them.
+func (f *EventFilter) queries(queries url.Values) url.Values {
+	queries = withList(queries, "expand", "resources")
+	if queries.Get("sort_by") == "" {
+		queries.Set("sort_by", "id")
+		queries.Set("sort_order", "asc")
+	}
+	if f == nil {
+		return queries
+	}
+	if !f.Since.IsZero() {
+		queries.Add("filter[]", "timestamp>"+f.Since.UTC().Format(time.RFC3339))
+	}
+	if !f.Until.IsZero() {
+		queries.Add("filter[]", "timestamp<"+f.Until.UTC().Format(time.RFC3339))
+	}
+	if f.TargetType != "" {
+		queries.Add("filter[]", "target_type="+f.TargetType)
+	}
+	if f.TargetID != "" {
+		queries.Add("filter[]", "target_id="+f.TargetID)
+	}
+	if f.EventType != "" {
+		queries.Add("filter[]", "event_type="+f.EventType)
+	}
+	return queries
+}
+
+// ListEventStreams returns the events matching the filter, the filter is merged into the queries.
+func (c *Client) ListEventStreams(filter *EventFilter, queries url.Values) (*EventStreams, error) {
+	return c.listEventStreams(context.Background(), filter.queries(queries))
+}
+
+func (c *Client) listEventStreams(ctx context.Context, queries url.Values) (*EventStreams, error) {
+	builder := NewRequestBuilder(GET).WithContext(ctx)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/event_streams", nil, queries)
+	if err != nil {
+		return nil, err
+	}
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	e := &EventStreams{}
+	if err := json.Unmarshal(resp.RawResult, &e); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+// EventCursor is the position of an event in the event stream, the cursor of the last event
+// processed can be passed to Follow for resuming from there.
+type EventCursor struct {
+	// ID of the last event received, takes precedence over Since when set
+	LastID string
+	// Follow the events raised after Since when no event was received yet
+	Since time.Time
+}
+
+type FollowedEvent struct {
+	Event  EventStream
+	Cursor EventCursor
+}
+
+// Follow polls the event stream every interval for the events after the cursor and emits them on the
+// returned channel in the order they were raised, a zero cursor follows the events raised from now on.
+// The default interval is used if interval isn't positive. Following stops when ctx is done or on the
+// first error, which is sent on the error channel, both channels are closed then.
+func (c *Client) Follow(ctx context.Context, since EventCursor, interval time.Duration) (<-chan FollowedEvent, <-chan error) {
+	events := make(chan FollowedEvent)
+	errs := make(chan error, 1)
+	if interval <= 0 {
+		interval = defaultEventPollInterval
+	}
+
+	go func() {
+		defer close(events)
+		defer close(errs)
+
+		cursor := since
+		if cursor == (EventCursor{}) {
+			lastID, err := c.latestEventID(ctx)
+			if err != nil {
+				if ctx.Err() == nil {
+					errs <- err
+				}
+				return
+			}
+			cursor.LastID = lastID
+		}
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			for {
+				queries := (&EventFilter{Since: cursor.Since}).queries(nil)
+				if cursor.LastID != "" {
+					queries.Del("filter[]")
+					queries.Add("filter[]", "id>"+cursor.LastID)
+				}
+				queries.Set("limit", strconv.Itoa(eventPageSize))
+
+				page, err := c.listEventStreams(ctx, queries)
+				if err != nil {
+					if ctx.Err() == nil {
+						errs <- err
+					}
+					return
+				}
+				for _, event := range page.Resources {
+					cursor = EventCursor{LastID: event.ID, Since: cursor.Since}
+					select {
+					case events <- FollowedEvent{Event: event, Cursor: cursor}:
+					case <-ctx.Done():
+						return
+					}
+				}
+				// Keep reading without waiting as long as full pages are returned.
+				if len(page.Resources) < eventPageSize {
+					break
+				}
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+
+	return events, errs
+}
+
+// latestEventID returns the id of the last event of the stream, or an empty id if there are no events.
+func (c *Client) latestEventID(ctx context.Context) (string, error) {
+	queries := url.Values{
+		"expand":     []string{"resources"},
+		"attributes": []string{"id"},
+		"sort_by":    []string{"id"},
+		"sort_order": []string{"desc"},
+		"limit":      []string{"1"},
+	}
+	page, err := c.listEventStreams(ctx, queries)
+	if err != nil {
+		return "", err
+	}
+	if len(page.Resources) == 0 {
+		return "", nil
+	}
+	return page.Resources[0].ID, nil
+}
+
+type Notifications struct {
+	MangeIQListResource
+	Resources []Notification `json:"resources"`
+}
+
+type Notification struct {
+	Href    string              `json:"href"`
+	ID      string              `json:"id"`
+	Seen    bool                `json:"seen"`
+	Details NotificationDetails `json:"details"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (n *Notification) UnmarshalJSON(b []byte) error {
+	type alias Notification
+	extra, err := unmarshalWithExtra(b, (*alias)(n))
+	if err != nil {
+		return err
+	}
+	n.Extra = extra
+	return nil
+}
+
+func (n Notification) MarshalJSON() ([]byte, error) {
+	type alias Notification
+	return marshalWithExtra(alias(n), n.Extra)
+}
+
+type NotificationDetails struct {
+	ID        string                 `json:"id"`
+	Level     string                 `json:"level"`
+	Text      string                 `json:"text"`
+	Bindings  map[string]interface{} `json:"bindings"`
+	CreatedAt time.Time              `json:"created_at"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (n *NotificationDetails) UnmarshalJSON(b []byte) error {
+	type alias NotificationDetails
+	extra, err := unmarshalWithExtra(b, (*alias)(n))
+	if err != nil {
+		return err
+	}
+	n.Extra = extra
+	return nil
+}
+
+func (n NotificationDetails) MarshalJSON() ([]byte, error) {
+	type alias NotificationDetails
+	return marshalWithExtra(alias(n), n.Extra)
+}
+
+func (c *Client) ListNotifications(queries url.Values) (*Notifications, error) {
+	builder := NewRequestBuilder(GET)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/notifications", nil, queries)
+	if err != nil {
+		return nil, err
+	}
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	n := &Notifications{}
+	if err := json.Unmarshal(resp.RawResult, &n); err != nil {
+		return nil, err
+	}
+	return n, nil
+}
+
+func (c *Client) GetNotification(id string, queries url.Values) (*Notification, error) {
+	builder := NewRequestBuilder(GET)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/notifications/"+id, nil, queries)
+	if err != nil {
+		return nil, err
+	}
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	n := &Notification{}
+	if err := json.Unmarshal(resp.RawResult, &n); err != nil {
+		return nil, err
+	}
+	return n, nil
+}
+
+func (c *Client) MarkNotificationAsSeen(ctx context.Context, id string) (*ActionResult, error) {
+	return c.doAction(ctx, POST, c.Authenticator.GetBaseURL(), "/notifications/"+id, "mark_as_seen", nil)
+}
+
+func (c *Client) DeleteNotification(ctx context.Context, id string) (*ActionResult, error) {
+	return c.doAction(ctx, POST, c.Authenticator.GetBaseURL(), "/notifications/"+id, "delete", nil)
+}
@@ -0,0 +1,136 @@
+package manageiq
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// eventServer serves the event stream of the ids 1..last, new events are raised with raise.
+type eventServer struct {
+	mu   sync.Mutex
+	last int
+}
+
+func (s *eventServer) raise(n int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.last += n
+}
+
+func (s *eventServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	queries := r.URL.Query()
+	ids := []int{}
+	if queries.Get("sort_order") == "desc" {
+		if s.last > 0 {
+			ids = append(ids, s.last)
+		}
+	} else {
+		after := 0
+		for _, filter := range queries["filter[]"] {
+			if strings.HasPrefix(filter, "id>") {
+				after, _ = strconv.Atoi(strings.TrimPrefix(filter, "id>"))
+			}
+		}
+		limit, _ := strconv.Atoi(queries.Get("limit"))
+		for id := after + 1; id <= s.last && len(ids) < limit; id++ {
+			ids = append(ids, id)
+		}
+	}
+
+	page := map[string]interface{}{"name": "event_streams"}
+	resources := []map[string]interface{}{}
+	for _, id := range ids {
+		resources = append(resources, map[string]interface{}{"id": strconv.Itoa(id), "event_type": "vm_start"})
+	}
+	page["resources"] = resources
+	json.NewEncoder(w).Encode(page)
+}
+
+func TestFollow(t *testing.T) {
+	tests := []struct {
+		name   string
+		events int
+		since  EventCursor
+		raised int
+		want   []string
+	}{
+		{
+			name:   "zero cursor follows the new events",
+			events: 3,
+			raised: 2,
+			want:   []string{"4", "5"},
+		},
+		{
+			name:   "resume from the last id",
+			events: 3,
+			since:  EventCursor{LastID: "1"},
+			raised: 1,
+			want:   []string{"2", "3", "4"},
+		},
+		{
+			name:   "cursor advances across full pages",
+			events: 0,
+			since:  EventCursor{LastID: "0"},
+			raised: eventPageSize + 2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			events := &eventServer{last: tt.events}
+			server := httptest.NewServer(events)
+			defer server.Close()
+			c := &Client{
+				Authenticator: &BearerAuthenticator{Token: "token", BaseURL: server.URL},
+				HTTPClient:    server.Client(),
+			}
+			want := tt.want
+			if want == nil {
+				for id := tt.events + 1; id <= tt.events+tt.raised; id++ {
+					want = append(want, strconv.Itoa(id))
+				}
+			}
+
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			followed, errs := c.Follow(ctx, tt.since, 10*time.Millisecond)
+			// Let the zero cursor be resolved before raising the events.
+			time.Sleep(50 * time.Millisecond)
+			events.raise(tt.raised)
+
+			got := []string{}
+			for len(got) < len(want) {
+				select {
+				case event := <-followed:
+					if event.Cursor.LastID != event.Event.ID {
+						t.Fatalf("cursor %s doesn't match the event %s", event.Cursor.LastID, event.Event.ID)
+					}
+					got = append(got, event.Event.ID)
+				case err := <-errs:
+					t.Fatalf("unexpected error: %v", err)
+				case <-ctx.Done():
+					t.Fatalf("timed out, got %v", got)
+				}
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("got %v, want %v", got, want)
+			}
+
+			cancel()
+			for range followed {
+			}
+			if err, ok := <-errs; ok {
+				t.Errorf("unexpected error after cancel: %v", err)
+			}
+		})
+	}
+}
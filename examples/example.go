@@ -15,12 +15,12 @@ func main() {
 		BaseURL:  "https://127.0.0.1:8443/api",
 		Insecure: true,
 	}
-	//g, err := manageiq.NewClient(a, manageiq.ClientParams{}).GetGroup("2")
+	//g, err := manageiq.NewClient(a, manageiq.ClientParams{}).GetGroup("2", nil)
 	//if err != nil {
 	//	log.Printf("errored getting services: %+v", err)
 	//}
 	//spew.Dump(g)
-	s, err := manageiq.NewClient(a, manageiq.ClientParams{}).GetServiceCatalogs()
+	s, err := manageiq.NewClient(a, manageiq.ClientParams{}).GetServiceCatalogs(nil)
 	if err != nil {
 		log.Printf("errored getting services: %+v", err)
 	}
@@ -2,31 +2,50 @@ package manageiq
 
 import (
 	"encoding/json"
+	"net/url"
+	"time"
 )
 
 type MangeIQListResource struct {
 	Name      string     `json:"name"`
 	ID        string     `json:"id"`
-	Count     float64    `json:"count"`
-	SubCount  float64    `json:"subcount"`
-	Pages     float64    `json:"pages"`
+	Count     int        `json:"count"`
+	SubCount  int        `json:"subcount"`
+	Pages     int        `json:"pages"`
 	Resources []Resource `json:"resources"`
 	Actions   []Action   `json:"actions"`
 	Links     Links      `json:"links"`
 }
 
 type Group struct {
-	CreatedOn           string   `json:"created_on"`
-	DetailedDescription string   `json:"detailed_description"`
-	Actions             []Action `json:"actions"`
-	GroupType           string   `json:"group_type"`
-	Sequence            float64  `json:"sequence"`
-	UpdatedOn           string   `json:"updated_on"`
-	Settings            string   `json:"settings"`
-	TenantID            string   `json:"tenant_id"`
-	Href                string   `json:"href"`
-	ID                  string   `json:"id"`
-	Description         string   `json:"description"`
+	CreatedOn           time.Time `json:"created_on"`
+	DetailedDescription string    `json:"detailed_description"`
+	Actions             []Action  `json:"actions"`
+	GroupType           string    `json:"group_type"`
+	Sequence            int       `json:"sequence"`
+	UpdatedOn           time.Time `json:"updated_on"`
+	Settings            string    `json:"settings"`
+	TenantID            string    `json:"tenant_id"`
+	Href                string    `json:"href"`
+	ID                  string    `json:"id"`
+	Description         string    `json:"description"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (g *Group) UnmarshalJSON(b []byte) error {
+	type alias Group
+	extra, err := unmarshalWithExtra(b, (*alias)(g))
+	if err != nil {
+		return err
+	}
+	g.Extra = extra
+	return nil
+}
+
+func (g Group) MarshalJSON() ([]byte, error) {
+	type alias Group
+	return marshalWithExtra(alias(g), g.Extra)
 }
 
 type Groups struct {
@@ -49,11 +68,28 @@ type Resource struct {
 	Href string `json:"href"`
 	ID   string `json:"id"`
 	Name string `json:"name"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (r *Resource) UnmarshalJSON(b []byte) error {
+	type alias Resource
+	extra, err := unmarshalWithExtra(b, (*alias)(r))
+	if err != nil {
+		return err
+	}
+	r.Extra = extra
+	return nil
+}
+
+func (r Resource) MarshalJSON() ([]byte, error) {
+	type alias Resource
+	return marshalWithExtra(alias(r), r.Extra)
 }
 
-func (c *Client) GetGroups() (*Groups, error) {
+func (c *Client) GetGroups(queries url.Values) (*Groups, error) {
 	builder := NewRequestBuilder(GET)
-	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/groups", nil, nil)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/groups", nil, queries)
 	if err != nil {
 		return nil, err
 	}
@@ -73,9 +109,9 @@ func (c *Client) GetGroups() (*Groups, error) {
 	return g, nil
 }
 
-func (c *Client) GetGroup(id string) (*Group, error) {
+func (c *Client) GetGroup(id string, queries url.Values) (*Group, error) {
 	builder := NewRequestBuilder(GET)
-	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/groups/"+id, nil, nil)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/groups/"+id, nil, queries)
 	if err != nil {
 		return nil, err
 	}
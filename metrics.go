@@ -0,0 +1,219 @@
+package manageiq
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"sort"
+	"strconv"
+	"time"
+)
+
+const (
+	CaptureIntervalHourly = "hourly"
+	CaptureIntervalDaily  = "daily"
+
+	MetricResourceTypeVM      = "VmOrTemplate"
+	MetricResourceTypeHost    = "Host"
+	MetricResourceTypeService = "Service"
+
+	metricRollupsPageSize = 1000
+)
+
+type MetricRollups struct {
+	MangeIQListResource
+	Resources []MetricRollup `json:"resources"`
+}
+
+// MetricRollup holds the metrics captured for a resource, the metrics which weren't captured are nil.
+type MetricRollup struct {
+	Href                string    `json:"href"`
+	ID                  string    `json:"id"`
+	Timestamp           time.Time `json:"timestamp"`
+	CaptureInterval     string    `json:"capture_interval_name"`
+	ResourceType        string    `json:"resource_type"`
+	ResourceID          string    `json:"resource_id"`
+	ResourceName        string    `json:"resource_name"`
+	CPUUsageRateAverage *float64  `json:"cpu_usage_rate_average"`
+	CPUUsageMHzAverage  *float64  `json:"cpu_usagemhz_rate_average"`
+	DerivedVMNumVCPUs   *float64  `json:"derived_vm_numvcpus"`
+	// Memory usage in percent
+	MemUsageAbsoluteAverage *float64 `json:"mem_usage_absolute_average"`
+	// Memory used and available in MB
+	DerivedMemoryUsed      *float64 `json:"derived_memory_used"`
+	DerivedMemoryAvailable *float64 `json:"derived_memory_available"`
+	// Disk and network usage in KBps
+	DiskUsageRateAverage *float64 `json:"disk_usage_rate_average"`
+	NetUsageRateAverage  *float64 `json:"net_usage_rate_average"`
+	// Disk storage used and allocated in bytes
+	DerivedVMUsedDiskStorage      *float64 `json:"derived_vm_used_disk_storage"`
+	DerivedVMAllocatedDiskStorage *float64 `json:"derived_vm_allocated_disk_storage"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (m *MetricRollup) UnmarshalJSON(b []byte) error {
+	type alias MetricRollup
+	extra, err := unmarshalWithExtra(b, (*alias)(m))
+	if err != nil {
+		return err
+	}
+	m.Extra = extra
+	return nil
+}
+
+func (m MetricRollup) MarshalJSON() ([]byte, error) {
+	type alias MetricRollup
+	return marshalWithExtra(alias(m), m.Extra)
+}
+
+type MetricPoint struct {
+	Timestamp time.Time
+	Value     float64
+}
+
+// MetricSeries holds the rollups of a resource ordered by timestamp along with the main series extracted from them.
+type MetricSeries struct {
+	ResourceType string
+	ResourceID   string
+	Rollups      []MetricRollup
+	// CPU and memory usage in percent
+	CPUUsage    []MetricPoint
+	MemoryUsage []MetricPoint
+	// Disk and network usage in KBps
+	DiskUsage    []MetricPoint
+	NetworkUsage []MetricPoint
+}
+
+func (s *MetricSeries) add(rollup MetricRollup) {
+	ts := rollup.Timestamp
+	s.Rollups = append(s.Rollups, rollup)
+	s.CPUUsage = appendMetricPoint(s.CPUUsage, ts, rollup.CPUUsageRateAverage)
+	s.MemoryUsage = appendMetricPoint(s.MemoryUsage, ts, rollup.MemUsageAbsoluteAverage)
+	s.DiskUsage = appendMetricPoint(s.DiskUsage, ts, rollup.DiskUsageRateAverage)
+	s.NetworkUsage = appendMetricPoint(s.NetworkUsage, ts, rollup.NetUsageRateAverage)
+}
+
+// appendMetricPoint appends the value to the points unless the metric wasn't captured.
+func appendMetricPoint(points []MetricPoint, ts time.Time, value *float64) []MetricPoint {
+	if value == nil {
+		return points
+	}
+	return append(points, MetricPoint{Timestamp: ts, Value: *value})
+}
+
+// GetMetricRollups returns the metric rollups of the resources captured at the interval between start and end,
+// keyed by the resource id. All the pages of the time window are fetched.
+func (c *Client) GetMetricRollups(ctx context.Context, resourceType string, ids []string, interval string, start, end time.Time) (map[string]*MetricSeries, error) {
+	queries := url.Values{
+		"resource_type":    []string{resourceType},
+		"capture_interval": []string{interval},
+		"start_date":       []string{start.UTC().Format(time.RFC3339)},
+		"expand":           []string{"resources"},
+		"sort_by":          []string{"timestamp,id"},
+		"sort_order":       []string{"asc,asc"},
+		"limit":            []string{strconv.Itoa(metricRollupsPageSize)},
+	}
+	if !end.IsZero() {
+		queries.Set("end_date", end.UTC().Format(time.RFC3339))
+	}
+	if len(ids) > 0 {
+		queries["resource_ids[]"] = ids
+	}
+
+	series := map[string]*MetricSeries{}
+	for offset := 0; ; offset += metricRollupsPageSize {
+		queries.Set("offset", strconv.Itoa(offset))
+		page, err := c.listMetricRollups(ctx, "/metric_rollups", queries)
+		if err != nil {
+			return nil, err
+		}
+		for _, rollup := range page.Resources {
+			s, ok := series[rollup.ResourceID]
+			if !ok {
+				s = &MetricSeries{ResourceType: rollup.ResourceType, ResourceID: rollup.ResourceID}
+				series[rollup.ResourceID] = s
+			}
+			s.add(rollup)
+		}
+		if len(page.Resources) < metricRollupsPageSize {
+			break
+		}
+	}
+
+	for _, s := range series {
+		sortMetricSeries(s)
+	}
+	return series, nil
+}
+
+// sortMetricSeries orders the series by timestamp, the pages are sorted by the server but
+// the order isn't guaranteed across them.
+func sortMetricSeries(s *MetricSeries) {
+	rollups := s.Rollups
+	before := func(i, j int) bool { return rollups[i].Timestamp.Before(rollups[j].Timestamp) }
+	if sort.SliceIsSorted(rollups, before) {
+		return
+	}
+	*s = MetricSeries{ResourceType: s.ResourceType, ResourceID: s.ResourceID}
+	sort.SliceStable(rollups, before)
+	for _, rollup := range rollups {
+		s.add(rollup)
+	}
+}
+
+func (c *Client) listMetricRollups(ctx context.Context, path string, queries url.Values) (*MetricRollups, error) {
+	builder := NewRequestBuilder(GET).WithContext(ctx)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), path, nil, queries)
+	if err != nil {
+		return nil, err
+	}
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	m := &MetricRollups{}
+	if err := json.Unmarshal(resp.RawResult, &m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// GetResourceMetricRollups returns the metric rollups of a single resource using its metric_rollups
+// subcollection, e.g. collection vms, hosts or services.
+func (c *Client) GetResourceMetricRollups(ctx context.Context, collection, id, interval string, start, end time.Time) (*MetricSeries, error) {
+	queries := url.Values{
+		"capture_interval": []string{interval},
+		"start_date":       []string{start.UTC().Format(time.RFC3339)},
+		"expand":           []string{"resources"},
+		"sort_by":          []string{"timestamp,id"},
+		"sort_order":       []string{"asc,asc"},
+		"limit":            []string{strconv.Itoa(metricRollupsPageSize)},
+	}
+	if !end.IsZero() {
+		queries.Set("end_date", end.UTC().Format(time.RFC3339))
+	}
+
+	series := &MetricSeries{ResourceID: id}
+	for offset := 0; ; offset += metricRollupsPageSize {
+		queries.Set("offset", strconv.Itoa(offset))
+		page, err := c.listMetricRollups(ctx, "/"+collection+"/"+id+"/metric_rollups", queries)
+		if err != nil {
+			return nil, err
+		}
+		for _, rollup := range page.Resources {
+			series.ResourceType = rollup.ResourceType
+			series.add(rollup)
+		}
+		if len(page.Resources) < metricRollupsPageSize {
+			break
+		}
+	}
+	sortMetricSeries(series)
+	return series, nil
+}
@@ -0,0 +1,154 @@
+package manageiq
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sort"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+// testRollups returns n rollups alternating between the resources 1 and 2, one hour apart and in the
+// given order. The cpu usage of every third rollup isn't captured.
+func testRollups(n int, descending bool) []map[string]interface{} {
+	start := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	rollups := make([]map[string]interface{}, 0, n)
+	for i := 0; i < n; i++ {
+		hour := i
+		if descending {
+			hour = n - i
+		}
+		rollup := map[string]interface{}{
+			"id":                         strconv.Itoa(i + 1),
+			"timestamp":                  start.Add(time.Duration(hour) * time.Hour).Format(time.RFC3339),
+			"capture_interval_name":      CaptureIntervalHourly,
+			"resource_type":              MetricResourceTypeVM,
+			"resource_id":                strconv.Itoa(i%2 + 1),
+			"cpu_usage_rate_average":     float64(i),
+			"mem_usage_absolute_average": 50.5,
+		}
+		if i%3 == 0 {
+			rollup["cpu_usage_rate_average"] = nil
+		}
+		rollups = append(rollups, rollup)
+	}
+	return rollups
+}
+
+func TestGetMetricRollups(t *testing.T) {
+	tests := []struct {
+		name        string
+		rollups     []map[string]interface{}
+		wantOffsets []string
+		wantCounts  map[string]int
+		wantCPU     map[string]int
+	}{
+		{
+			name:        "single page",
+			rollups:     testRollups(5, false),
+			wantOffsets: []string{"0"},
+			wantCounts:  map[string]int{"1": 3, "2": 2},
+			wantCPU:     map[string]int{"1": 2, "2": 1},
+		},
+		{
+			name:        "full last page",
+			rollups:     testRollups(metricRollupsPageSize, false),
+			wantOffsets: []string{"0", "1000"},
+			wantCounts:  map[string]int{"1": 500, "2": 500},
+			wantCPU:     map[string]int{"1": 333, "2": 333},
+		},
+		{
+			name:        "several pages merged",
+			rollups:     testRollups(2*metricRollupsPageSize+1, false),
+			wantOffsets: []string{"0", "1000", "2000"},
+			wantCounts:  map[string]int{"1": 1001, "2": 1000},
+			wantCPU:     map[string]int{"1": 667, "2": 667},
+		},
+		{
+			name:        "unordered pages sorted by timestamp",
+			rollups:     testRollups(metricRollupsPageSize+4, true),
+			wantOffsets: []string{"0", "1000"},
+			wantCounts:  map[string]int{"1": 502, "2": 502},
+			wantCPU:     map[string]int{"1": 334, "2": 335},
+		},
+		{
+			name:        "no rollups",
+			rollups:     nil,
+			wantOffsets: []string{"0"},
+			wantCounts:  map[string]int{},
+			wantCPU:     map[string]int{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var mu sync.Mutex
+			offsets := []string{}
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				queries := r.URL.Query()
+				mu.Lock()
+				offsets = append(offsets, queries.Get("offset"))
+				mu.Unlock()
+				if got := queries["resource_ids[]"]; !reflect.DeepEqual(got, []string{"1", "2"}) {
+					t.Errorf("unexpected resource ids %v", got)
+				}
+				offset, _ := strconv.Atoi(queries.Get("offset"))
+				limit, _ := strconv.Atoi(queries.Get("limit"))
+				page := []map[string]interface{}{}
+				for i := offset; i < len(tt.rollups) && i < offset+limit; i++ {
+					page = append(page, tt.rollups[i])
+				}
+				json.NewEncoder(w).Encode(map[string]interface{}{"name": "metric_rollups", "resources": page})
+			}))
+			defer server.Close()
+			c := &Client{
+				Authenticator: &BearerAuthenticator{Token: "token", BaseURL: server.URL},
+				HTTPClient:    server.Client(),
+			}
+
+			start := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+			series, err := c.GetMetricRollups(context.Background(), MetricResourceTypeVM, []string{"1", "2"}, CaptureIntervalHourly, start, time.Time{})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(offsets, tt.wantOffsets) {
+				t.Errorf("got offsets %v, want %v", offsets, tt.wantOffsets)
+			}
+
+			counts := map[string]int{}
+			cpu := map[string]int{}
+			for id, s := range series {
+				if s.ResourceID != id || s.ResourceType != MetricResourceTypeVM {
+					t.Errorf("unexpected series %s/%s keyed by %s", s.ResourceType, s.ResourceID, id)
+				}
+				counts[id] = len(s.Rollups)
+				cpu[id] = len(s.CPUUsage)
+				if len(s.MemoryUsage) != len(s.Rollups) {
+					t.Errorf("got %d memory points for %d rollups", len(s.MemoryUsage), len(s.Rollups))
+				}
+				sorted := sort.SliceIsSorted(s.Rollups, func(i, j int) bool {
+					return s.Rollups[i].Timestamp.Before(s.Rollups[j].Timestamp)
+				})
+				if !sorted {
+					t.Errorf("rollups of %s aren't sorted by timestamp", id)
+				}
+				for i, point := range s.CPUUsage {
+					if i > 0 && point.Timestamp.Before(s.CPUUsage[i-1].Timestamp) {
+						t.Errorf("cpu usage of %s isn't sorted by timestamp", id)
+						break
+					}
+				}
+			}
+			if !reflect.DeepEqual(counts, tt.wantCounts) {
+				t.Errorf("got rollup counts %v, want %v", counts, tt.wantCounts)
+			}
+			if !reflect.DeepEqual(cpu, tt.wantCPU) {
+				t.Errorf("got cpu point counts %v, want %v", cpu, tt.wantCPU)
+			}
+		})
+	}
+}
@@ -0,0 +1,338 @@
+package manageiq
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+const (
+	OrchestrationTemplateHOT            = "ManageIQ::Providers::Openstack::CloudManager::OrchestrationTemplate"
+	OrchestrationTemplateCloudFormation = "ManageIQ::Providers::Amazon::CloudManager::OrchestrationTemplate"
+	OrchestrationTemplateAzure          = "ManageIQ::Providers::Azure::CloudManager::OrchestrationTemplate"
+	OrchestrationTemplateVNF            = "ManageIQ::Providers::Openstack::CloudManager::VnfdTemplate"
+)
+
+type OrchestrationTemplates struct {
+	MangeIQListResource
+	Resources []OrchestrationTemplate `json:"resources"`
+}
+
+type OrchestrationTemplate struct {
+	Href        string    `json:"href,omitempty"`
+	ID          string    `json:"id,omitempty"`
+	Name        string    `json:"name,omitempty"`
+	Description string    `json:"description,omitempty"`
+	Type        string    `json:"type,omitempty"`
+	Content     string    `json:"content,omitempty"`
+	Draft       *bool     `json:"draft,omitempty"`
+	Orderable   *bool     `json:"orderable,omitempty"`
+	EMSID       string    `json:"ems_id,omitempty"`
+	MD5         string    `json:"md5,omitempty"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+	Actions     []Action  `json:"actions,omitempty"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (o *OrchestrationTemplate) UnmarshalJSON(b []byte) error {
+	type alias OrchestrationTemplate
+	extra, err := unmarshalWithExtra(b, (*alias)(o))
+	if err != nil {
+		return err
+	}
+	o.Extra = extra
+	return nil
+}
+
+func (o OrchestrationTemplate) MarshalJSON() ([]byte, error) {
+	type alias OrchestrationTemplate
+	return marshalWithExtra(alias(o), o.Extra)
+}
+
+type orchestrationTemplateResults struct {
+	Results []OrchestrationTemplate `json:"results"`
+}
+
+type OrchestrationStacks struct {
+	MangeIQListResource
+	Resources []OrchestrationStack `json:"resources"`
+}
+
+type OrchestrationStack struct {
+	Href                    string    `json:"href"`
+	ID                      string    `json:"id"`
+	Name                    string    `json:"name"`
+	Description             string    `json:"description"`
+	Type                    string    `json:"type"`
+	Status                  string    `json:"status"`
+	StatusReason            string    `json:"status_reason"`
+	EMSID                   string    `json:"ems_id"`
+	EMSRef                  string    `json:"ems_ref"`
+	OrchestrationTemplateID string    `json:"orchestration_template_id"`
+	ServiceID               string    `json:"service_id"`
+	CreatedAt               time.Time `json:"created_at"`
+	UpdatedAt               time.Time `json:"updated_at"`
+	RetiresOn               time.Time `json:"retires_on"`
+	Retired                 bool      `json:"retired"`
+	Actions                 []Action  `json:"actions"`
+	// Only populated when requested with attributes=parameters,outputs,resources
+	Parameters []OrchestrationStackParameter `json:"parameters"`
+	Outputs    []OrchestrationStackOutput    `json:"outputs"`
+	Resources  []OrchestrationStackResource  `json:"resources"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (o *OrchestrationStack) UnmarshalJSON(b []byte) error {
+	type alias OrchestrationStack
+	extra, err := unmarshalWithExtra(b, (*alias)(o))
+	if err != nil {
+		return err
+	}
+	o.Extra = extra
+	return nil
+}
+
+func (o OrchestrationStack) MarshalJSON() ([]byte, error) {
+	type alias OrchestrationStack
+	return marshalWithExtra(alias(o), o.Extra)
+}
+
+type OrchestrationStackParameter struct {
+	ID                   string `json:"id"`
+	Name                 string `json:"name"`
+	Value                string `json:"value"`
+	StackID              string `json:"stack_id"`
+	OrchestrationStackID string `json:"orchestration_stack_id"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (o *OrchestrationStackParameter) UnmarshalJSON(b []byte) error {
+	type alias OrchestrationStackParameter
+	extra, err := unmarshalWithExtra(b, (*alias)(o))
+	if err != nil {
+		return err
+	}
+	o.Extra = extra
+	return nil
+}
+
+func (o OrchestrationStackParameter) MarshalJSON() ([]byte, error) {
+	type alias OrchestrationStackParameter
+	return marshalWithExtra(alias(o), o.Extra)
+}
+
+type OrchestrationStackOutput struct {
+	ID          string `json:"id"`
+	Key         string `json:"key"`
+	Value       string `json:"value"`
+	Description string `json:"description"`
+	EMSRef      string `json:"ems_ref"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (o *OrchestrationStackOutput) UnmarshalJSON(b []byte) error {
+	type alias OrchestrationStackOutput
+	extra, err := unmarshalWithExtra(b, (*alias)(o))
+	if err != nil {
+		return err
+	}
+	o.Extra = extra
+	return nil
+}
+
+func (o OrchestrationStackOutput) MarshalJSON() ([]byte, error) {
+	type alias OrchestrationStackOutput
+	return marshalWithExtra(alias(o), o.Extra)
+}
+
+type OrchestrationStackResource struct {
+	ID                   string    `json:"id"`
+	Name                 string    `json:"name"`
+	Description          string    `json:"description"`
+	LogicalResource      string    `json:"logical_resource"`
+	PhysicalResource     string    `json:"physical_resource"`
+	ResourceCategory     string    `json:"resource_category"`
+	ResourceStatus       string    `json:"resource_status"`
+	ResourceStatusReason string    `json:"resource_status_reason"`
+	LastUpdated          time.Time `json:"last_updated"`
+	EMSRef               string    `json:"ems_ref"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (o *OrchestrationStackResource) UnmarshalJSON(b []byte) error {
+	type alias OrchestrationStackResource
+	extra, err := unmarshalWithExtra(b, (*alias)(o))
+	if err != nil {
+		return err
+	}
+	o.Extra = extra
+	return nil
+}
+
+func (o OrchestrationStackResource) MarshalJSON() ([]byte, error) {
+	type alias OrchestrationStackResource
+	return marshalWithExtra(alias(o), o.Extra)
+}
+
+func (c *Client) ListOrchestrationTemplates(queries url.Values) (*OrchestrationTemplates, error) {
+	builder := NewRequestBuilder(GET)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/orchestration_templates", nil, queries)
+	if err != nil {
+		return nil, err
+	}
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	o := &OrchestrationTemplates{}
+	if err := json.Unmarshal(resp.RawResult, &o); err != nil {
+		return nil, err
+	}
+	return o, nil
+}
+
+func (c *Client) GetOrchestrationTemplate(id string, queries url.Values) (*OrchestrationTemplate, error) {
+	builder := NewRequestBuilder(GET)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/orchestration_templates/"+id, nil, queries)
+	if err != nil {
+		return nil, err
+	}
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	o := &OrchestrationTemplate{}
+	if err := json.Unmarshal(resp.RawResult, &o); err != nil {
+		return nil, err
+	}
+	return o, nil
+}
+
+func (c *Client) CreateOrchestrationTemplate(ctx context.Context, template *OrchestrationTemplate) (*OrchestrationTemplate, error) {
+	builder := NewRequestBuilder(POST).WithContext(ctx)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/orchestration_templates", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := builder.SetBodyContentJSON(&actionBody{Action: "create", Resource: template}); err != nil {
+		return nil, err
+	}
+
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	r := &orchestrationTemplateResults{}
+	if err := json.Unmarshal(resp.RawResult, &r); err != nil {
+		return nil, err
+	}
+	if len(r.Results) == 0 {
+		return nil, fmt.Errorf("no orchestration template returned in the response")
+	}
+	return &r.Results[0], nil
+}
+
+func (c *Client) UpdateOrchestrationTemplate(ctx context.Context, id string, template *OrchestrationTemplate) (*OrchestrationTemplate, error) {
+	builder := NewRequestBuilder(POST).WithContext(ctx)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/orchestration_templates/"+id, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := builder.SetBodyContentJSON(&actionBody{Action: "edit", Resource: template}); err != nil {
+		return nil, err
+	}
+
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	o := &OrchestrationTemplate{}
+	if err := json.Unmarshal(resp.RawResult, &o); err != nil {
+		return nil, err
+	}
+	return o, nil
+}
+
+func (c *Client) DeleteOrchestrationTemplate(ctx context.Context, id string) (*ActionResult, error) {
+	return c.doAction(ctx, DELETE, c.Authenticator.GetBaseURL(), "/orchestration_templates/"+id, "", nil)
+}
+
+func (c *Client) ListOrchestrationStacks(queries url.Values) (*OrchestrationStacks, error) {
+	builder := NewRequestBuilder(GET)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/orchestration_stacks", nil, queries)
+	if err != nil {
+		return nil, err
+	}
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	o := &OrchestrationStacks{}
+	if err := json.Unmarshal(resp.RawResult, &o); err != nil {
+		return nil, err
+	}
+	return o, nil
+}
+
+// GetOrchestrationStack returns the stack along with its parameters, outputs and resources.
+func (c *Client) GetOrchestrationStack(id string, queries url.Values) (*OrchestrationStack, error) {
+	return c.getOrchestrationStack(context.Background(), id, queries)
+}
+
+func (c *Client) getOrchestrationStack(ctx context.Context, id string, queries url.Values) (*OrchestrationStack, error) {
+	queries = withList(queries, "attributes", "parameters", "outputs", "resources")
+	builder := NewRequestBuilder(GET).WithContext(ctx)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/orchestration_stacks/"+id, nil, queries)
+	if err != nil {
+		return nil, err
+	}
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	o := &OrchestrationStack{}
+	if err := json.Unmarshal(resp.RawResult, &o); err != nil {
+		return nil, err
+	}
+	return o, nil
+}
@@ -0,0 +1,410 @@
+package manageiq
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"time"
+)
+
+type PhysicalServers struct {
+	MangeIQListResource
+	Resources []PhysicalServer `json:"resources"`
+}
+
+type PhysicalServer struct {
+	Href         string `json:"href"`
+	ID           string `json:"id"`
+	Name         string `json:"name"`
+	Type         string `json:"type"`
+	EMSID        string `json:"ems_id"`
+	EMSRef       string `json:"ems_ref"`
+	UIDEMS       string `json:"uid_ems"`
+	Hostname     string `json:"hostname"`
+	ProductName  string `json:"product_name"`
+	Manufacturer string `json:"manufacturer"`
+	MachineType  string `json:"machine_type"`
+	Model        string `json:"model"`
+	SerialNumber string `json:"serial_number"`
+	FRU          string `json:"field_replaceable_unit"`
+	// Power state of the server as returned by the provider
+	RawPowerState string `json:"raw_power_state"`
+	// Power state of the server normalized by ManageIQ
+	PowerState        PowerState `json:"power_state,omitempty"`
+	LocationLEDState  string     `json:"location_led_state"`
+	HealthState       string     `json:"health_state"`
+	PhysicalRackID    string     `json:"physical_rack_id"`
+	PhysicalChassisID string     `json:"physical_chassis_id"`
+	CreatedAt         time.Time  `json:"created_at"`
+	UpdatedAt         time.Time  `json:"updated_at"`
+	Actions           []Action   `json:"actions"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (p *PhysicalServer) UnmarshalJSON(b []byte) error {
+	type alias PhysicalServer
+	extra, err := unmarshalWithExtra(b, (*alias)(p))
+	if err != nil {
+		return err
+	}
+	p.Extra = extra
+	return nil
+}
+
+func (p PhysicalServer) MarshalJSON() ([]byte, error) {
+	type alias PhysicalServer
+	return marshalWithExtra(alias(p), p.Extra)
+}
+
+type PhysicalRacks struct {
+	MangeIQListResource
+	Resources []PhysicalRack `json:"resources"`
+}
+
+type PhysicalRack struct {
+	Href      string    `json:"href"`
+	ID        string    `json:"id"`
+	Name      string    `json:"name"`
+	EMSID     string    `json:"ems_id"`
+	EMSRef    string    `json:"ems_ref"`
+	UIDEMS    string    `json:"uid_ems"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+	Actions   []Action  `json:"actions"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (p *PhysicalRack) UnmarshalJSON(b []byte) error {
+	type alias PhysicalRack
+	extra, err := unmarshalWithExtra(b, (*alias)(p))
+	if err != nil {
+		return err
+	}
+	p.Extra = extra
+	return nil
+}
+
+func (p PhysicalRack) MarshalJSON() ([]byte, error) {
+	type alias PhysicalRack
+	return marshalWithExtra(alias(p), p.Extra)
+}
+
+type PhysicalChassises struct {
+	MangeIQListResource
+	Resources []PhysicalChassis `json:"resources"`
+}
+
+type PhysicalChassis struct {
+	Href               string    `json:"href"`
+	ID                 string    `json:"id"`
+	Name               string    `json:"name"`
+	EMSID              string    `json:"ems_id"`
+	EMSRef             string    `json:"ems_ref"`
+	UIDEMS             string    `json:"uid_ems"`
+	HealthState        string    `json:"health_state"`
+	OverallHealthState string    `json:"overall_health_state"`
+	LocationLEDState   string    `json:"location_led_state"`
+	PhysicalRackID     string    `json:"physical_rack_id"`
+	CreatedAt          time.Time `json:"created_at"`
+	UpdatedAt          time.Time `json:"updated_at"`
+	Actions            []Action  `json:"actions"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (p *PhysicalChassis) UnmarshalJSON(b []byte) error {
+	type alias PhysicalChassis
+	extra, err := unmarshalWithExtra(b, (*alias)(p))
+	if err != nil {
+		return err
+	}
+	p.Extra = extra
+	return nil
+}
+
+func (p PhysicalChassis) MarshalJSON() ([]byte, error) {
+	type alias PhysicalChassis
+	return marshalWithExtra(alias(p), p.Extra)
+}
+
+type PhysicalSwitches struct {
+	MangeIQListResource
+	Resources []PhysicalSwitch `json:"resources"`
+}
+
+type PhysicalSwitch struct {
+	Href        string `json:"href"`
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Type        string `json:"type"`
+	EMSID       string `json:"ems_id"`
+	UIDEMS      string `json:"uid_ems"`
+	SwitchUUID  string `json:"switch_uuid"`
+	HealthState string `json:"health_state"`
+	// Power state of the switch as returned by the provider
+	RawPowerState string `json:"raw_power_state"`
+	// Power state of the switch normalized by ManageIQ
+	PowerState PowerState `json:"power_state,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+	UpdatedAt  time.Time  `json:"updated_at"`
+	Actions    []Action   `json:"actions"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (p *PhysicalSwitch) UnmarshalJSON(b []byte) error {
+	type alias PhysicalSwitch
+	extra, err := unmarshalWithExtra(b, (*alias)(p))
+	if err != nil {
+		return err
+	}
+	p.Extra = extra
+	return nil
+}
+
+func (p PhysicalSwitch) MarshalJSON() ([]byte, error) {
+	type alias PhysicalSwitch
+	return marshalWithExtra(alias(p), p.Extra)
+}
+
+func (c *Client) ListPhysicalServers(queries url.Values) (*PhysicalServers, error) {
+	builder := NewRequestBuilder(GET)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/physical_servers", nil, queries)
+	if err != nil {
+		return nil, err
+	}
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	s := &PhysicalServers{}
+	if err := json.Unmarshal(resp.RawResult, &s); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (c *Client) GetPhysicalServer(id string, queries url.Values) (*PhysicalServer, error) {
+	builder := NewRequestBuilder(GET)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/physical_servers/"+id, nil, queries)
+	if err != nil {
+		return nil, err
+	}
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	s := &PhysicalServer{}
+	if err := json.Unmarshal(resp.RawResult, &s); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (c *Client) physicalServerAction(ctx context.Context, id, action string) (*ActionResult, error) {
+	return c.doAction(ctx, POST, c.Authenticator.GetBaseURL(), "/physical_servers/"+id, action, nil)
+}
+
+func (c *Client) PowerOnPhysicalServer(ctx context.Context, id string) (*ActionResult, error) {
+	return c.physicalServerAction(ctx, id, "power_on")
+}
+
+func (c *Client) PowerOffPhysicalServer(ctx context.Context, id string) (*ActionResult, error) {
+	return c.physicalServerAction(ctx, id, "power_off")
+}
+
+func (c *Client) RestartPhysicalServer(ctx context.Context, id string) (*ActionResult, error) {
+	return c.physicalServerAction(ctx, id, "restart")
+}
+
+func (c *Client) BlinkPhysicalServerLocLED(ctx context.Context, id string) (*ActionResult, error) {
+	return c.physicalServerAction(ctx, id, "blink_loc_led")
+}
+
+func (c *Client) TurnOnPhysicalServerLocLED(ctx context.Context, id string) (*ActionResult, error) {
+	return c.physicalServerAction(ctx, id, "turn_on_loc_led")
+}
+
+func (c *Client) TurnOffPhysicalServerLocLED(ctx context.Context, id string) (*ActionResult, error) {
+	return c.physicalServerAction(ctx, id, "turn_off_loc_led")
+}
+
+func (c *Client) RefreshPhysicalServer(ctx context.Context, id string) (*ActionResult, error) {
+	return c.physicalServerAction(ctx, id, "refresh")
+}
+
+func (c *Client) ListPhysicalRacks(queries url.Values) (*PhysicalRacks, error) {
+	builder := NewRequestBuilder(GET)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/physical_racks", nil, queries)
+	if err != nil {
+		return nil, err
+	}
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	r := &PhysicalRacks{}
+	if err := json.Unmarshal(resp.RawResult, &r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (c *Client) GetPhysicalRack(id string, queries url.Values) (*PhysicalRack, error) {
+	builder := NewRequestBuilder(GET)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/physical_racks/"+id, nil, queries)
+	if err != nil {
+		return nil, err
+	}
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	r := &PhysicalRack{}
+	if err := json.Unmarshal(resp.RawResult, &r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (c *Client) RefreshPhysicalRack(ctx context.Context, id string) (*ActionResult, error) {
+	return c.doAction(ctx, POST, c.Authenticator.GetBaseURL(), "/physical_racks/"+id, "refresh", nil)
+}
+
+func (c *Client) ListPhysicalChassis(queries url.Values) (*PhysicalChassises, error) {
+	builder := NewRequestBuilder(GET)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/physical_chassis", nil, queries)
+	if err != nil {
+		return nil, err
+	}
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	ch := &PhysicalChassises{}
+	if err := json.Unmarshal(resp.RawResult, &ch); err != nil {
+		return nil, err
+	}
+	return ch, nil
+}
+
+func (c *Client) GetPhysicalChassis(id string, queries url.Values) (*PhysicalChassis, error) {
+	builder := NewRequestBuilder(GET)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/physical_chassis/"+id, nil, queries)
+	if err != nil {
+		return nil, err
+	}
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	ch := &PhysicalChassis{}
+	if err := json.Unmarshal(resp.RawResult, &ch); err != nil {
+		return nil, err
+	}
+	return ch, nil
+}
+
+func (c *Client) physicalChassisAction(ctx context.Context, id, action string) (*ActionResult, error) {
+	return c.doAction(ctx, POST, c.Authenticator.GetBaseURL(), "/physical_chassis/"+id, action, nil)
+}
+
+func (c *Client) BlinkPhysicalChassisLocLED(ctx context.Context, id string) (*ActionResult, error) {
+	return c.physicalChassisAction(ctx, id, "blink_loc_led")
+}
+
+func (c *Client) TurnOnPhysicalChassisLocLED(ctx context.Context, id string) (*ActionResult, error) {
+	return c.physicalChassisAction(ctx, id, "turn_on_loc_led")
+}
+
+func (c *Client) TurnOffPhysicalChassisLocLED(ctx context.Context, id string) (*ActionResult, error) {
+	return c.physicalChassisAction(ctx, id, "turn_off_loc_led")
+}
+
+func (c *Client) RefreshPhysicalChassis(ctx context.Context, id string) (*ActionResult, error) {
+	return c.physicalChassisAction(ctx, id, "refresh")
+}
+
+func (c *Client) ListPhysicalSwitches(queries url.Values) (*PhysicalSwitches, error) {
+	builder := NewRequestBuilder(GET)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/physical_switches", nil, queries)
+	if err != nil {
+		return nil, err
+	}
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	s := &PhysicalSwitches{}
+	if err := json.Unmarshal(resp.RawResult, &s); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (c *Client) GetPhysicalSwitch(id string, queries url.Values) (*PhysicalSwitch, error) {
+	builder := NewRequestBuilder(GET)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/physical_switches/"+id, nil, queries)
+	if err != nil {
+		return nil, err
+	}
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	s := &PhysicalSwitch{}
+	if err := json.Unmarshal(resp.RawResult, &s); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (c *Client) RestartPhysicalSwitch(ctx context.Context, id string) (*ActionResult, error) {
+	return c.doAction(ctx, POST, c.Authenticator.GetBaseURL(), "/physical_switches/"+id, "restart", nil)
+}
+
+func (c *Client) RefreshPhysicalSwitch(ctx context.Context, id string) (*ActionResult, error) {
+	return c.doAction(ctx, POST, c.Authenticator.GetBaseURL(), "/physical_switches/"+id, "refresh", nil)
+}
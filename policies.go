@@ -0,0 +1,778 @@
+package manageiq
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+const (
+	PolicyTargetVMs       = "vms"
+	PolicyTargetHosts     = "hosts"
+	PolicyTargetProviders = "providers"
+)
+
+type Policies struct {
+	MangeIQListResource
+	Resources []Policy `json:"resources"`
+}
+
+type Policy struct {
+	Href        string                 `json:"href,omitempty"`
+	ID          string                 `json:"id,omitempty"`
+	GUID        string                 `json:"guid,omitempty"`
+	Name        string                 `json:"name,omitempty"`
+	Description string                 `json:"description,omitempty"`
+	Mode        string                 `json:"mode,omitempty"`
+	Towhat      string                 `json:"towhat,omitempty"`
+	Active      *bool                  `json:"active,omitempty"`
+	Expression  map[string]interface{} `json:"expression,omitempty"`
+	CreatedOn   time.Time              `json:"created_on"`
+	UpdatedOn   time.Time              `json:"updated_on"`
+	// Only used when creating or editing the policy
+	ConditionsIDs  []string        `json:"conditions_ids,omitempty"`
+	PolicyContents []PolicyContent `json:"policy_contents,omitempty"`
+	Actions        []Action        `json:"actions,omitempty"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (p *Policy) UnmarshalJSON(b []byte) error {
+	type alias Policy
+	extra, err := unmarshalWithExtra(b, (*alias)(p))
+	if err != nil {
+		return err
+	}
+	p.Extra = extra
+	return nil
+}
+
+func (p Policy) MarshalJSON() ([]byte, error) {
+	type alias Policy
+	return marshalWithExtra(alias(p), p.Extra)
+}
+
+// PolicyContent binds the policy actions run when the event is raised.
+type PolicyContent struct {
+	EventID string                `json:"event_id"`
+	Actions []PolicyContentAction `json:"actions,omitempty"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (p *PolicyContent) UnmarshalJSON(b []byte) error {
+	type alias PolicyContent
+	extra, err := unmarshalWithExtra(b, (*alias)(p))
+	if err != nil {
+		return err
+	}
+	p.Extra = extra
+	return nil
+}
+
+func (p PolicyContent) MarshalJSON() ([]byte, error) {
+	type alias PolicyContent
+	return marshalWithExtra(alias(p), p.Extra)
+}
+
+type PolicyContentAction struct {
+	ActionID string                 `json:"action_id"`
+	Opts     map[string]interface{} `json:"opts,omitempty"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (p *PolicyContentAction) UnmarshalJSON(b []byte) error {
+	type alias PolicyContentAction
+	extra, err := unmarshalWithExtra(b, (*alias)(p))
+	if err != nil {
+		return err
+	}
+	p.Extra = extra
+	return nil
+}
+
+func (p PolicyContentAction) MarshalJSON() ([]byte, error) {
+	type alias PolicyContentAction
+	return marshalWithExtra(alias(p), p.Extra)
+}
+
+type PolicyProfiles struct {
+	MangeIQListResource
+	Resources []PolicyProfile `json:"resources"`
+}
+
+type PolicyProfile struct {
+	Href        string    `json:"href,omitempty"`
+	ID          string    `json:"id,omitempty"`
+	GUID        string    `json:"guid,omitempty"`
+	Name        string    `json:"name,omitempty"`
+	Description string    `json:"description,omitempty"`
+	Mode        string    `json:"mode,omitempty"`
+	SetType     string    `json:"set_type,omitempty"`
+	ReadOnly    bool      `json:"read_only,omitempty"`
+	CreatedOn   time.Time `json:"created_on"`
+	UpdatedOn   time.Time `json:"updated_on"`
+	Actions     []Action  `json:"actions,omitempty"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (p *PolicyProfile) UnmarshalJSON(b []byte) error {
+	type alias PolicyProfile
+	extra, err := unmarshalWithExtra(b, (*alias)(p))
+	if err != nil {
+		return err
+	}
+	p.Extra = extra
+	return nil
+}
+
+func (p PolicyProfile) MarshalJSON() ([]byte, error) {
+	type alias PolicyProfile
+	return marshalWithExtra(alias(p), p.Extra)
+}
+
+type Conditions struct {
+	MangeIQListResource
+	Resources []Condition `json:"resources"`
+}
+
+type Condition struct {
+	Href        string                 `json:"href,omitempty"`
+	ID          string                 `json:"id,omitempty"`
+	GUID        string                 `json:"guid,omitempty"`
+	Name        string                 `json:"name,omitempty"`
+	Description string                 `json:"description,omitempty"`
+	Modifier    string                 `json:"modifier,omitempty"`
+	Towhat      string                 `json:"towhat,omitempty"`
+	Expression  map[string]interface{} `json:"expression,omitempty"`
+	CreatedOn   time.Time              `json:"created_on"`
+	UpdatedOn   time.Time              `json:"updated_on"`
+	Actions     []Action               `json:"actions,omitempty"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (c *Condition) UnmarshalJSON(b []byte) error {
+	type alias Condition
+	extra, err := unmarshalWithExtra(b, (*alias)(c))
+	if err != nil {
+		return err
+	}
+	c.Extra = extra
+	return nil
+}
+
+func (c Condition) MarshalJSON() ([]byte, error) {
+	type alias Condition
+	return marshalWithExtra(alias(c), c.Extra)
+}
+
+type PolicyActions struct {
+	MangeIQListResource
+	Resources []PolicyAction `json:"resources"`
+}
+
+type PolicyAction struct {
+	Href        string                 `json:"href,omitempty"`
+	ID          string                 `json:"id,omitempty"`
+	GUID        string                 `json:"guid,omitempty"`
+	Name        string                 `json:"name,omitempty"`
+	Description string                 `json:"description,omitempty"`
+	ActionType  string                 `json:"action_type,omitempty"`
+	Options     map[string]interface{} `json:"options,omitempty"`
+	CreatedOn   time.Time              `json:"created_on"`
+	UpdatedOn   time.Time              `json:"updated_on"`
+	Actions     []Action               `json:"actions,omitempty"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (p *PolicyAction) UnmarshalJSON(b []byte) error {
+	type alias PolicyAction
+	extra, err := unmarshalWithExtra(b, (*alias)(p))
+	if err != nil {
+		return err
+	}
+	p.Extra = extra
+	return nil
+}
+
+func (p PolicyAction) MarshalJSON() ([]byte, error) {
+	type alias PolicyAction
+	return marshalWithExtra(alias(p), p.Extra)
+}
+
+type Compliance struct {
+	ID           string    `json:"id"`
+	ResourceID   string    `json:"resource_id"`
+	ResourceType string    `json:"resource_type"`
+	Compliant    bool      `json:"compliant"`
+	EventType    string    `json:"event_type"`
+	Timestamp    time.Time `json:"timestamp"`
+	UpdatedOn    time.Time `json:"updated_on"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (c *Compliance) UnmarshalJSON(b []byte) error {
+	type alias Compliance
+	extra, err := unmarshalWithExtra(b, (*alias)(c))
+	if err != nil {
+		return err
+	}
+	c.Extra = extra
+	return nil
+}
+
+func (c Compliance) MarshalJSON() ([]byte, error) {
+	type alias Compliance
+	return marshalWithExtra(alias(c), c.Extra)
+}
+
+type policyResults struct {
+	Results []Policy `json:"results"`
+}
+
+type policyProfileResults struct {
+	Results []PolicyProfile `json:"results"`
+}
+
+type conditionResults struct {
+	Results []Condition `json:"results"`
+}
+
+type policyActionResults struct {
+	Results []PolicyAction `json:"results"`
+}
+
+func (c *Client) ListPolicies(queries url.Values) (*Policies, error) {
+	builder := NewRequestBuilder(GET)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/policies", nil, queries)
+	if err != nil {
+		return nil, err
+	}
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	p := &Policies{}
+	if err := json.Unmarshal(resp.RawResult, &p); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+func (c *Client) GetPolicy(id string, queries url.Values) (*Policy, error) {
+	builder := NewRequestBuilder(GET)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/policies/"+id, nil, queries)
+	if err != nil {
+		return nil, err
+	}
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	p := &Policy{}
+	if err := json.Unmarshal(resp.RawResult, &p); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+func (c *Client) CreatePolicy(ctx context.Context, p *Policy) (*Policy, error) {
+	builder := NewRequestBuilder(POST).WithContext(ctx)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/policies", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := builder.SetBodyContentJSON(&actionBody{Action: "create", Resource: p}); err != nil {
+		return nil, err
+	}
+
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	r := &policyResults{}
+	if err := json.Unmarshal(resp.RawResult, &r); err != nil {
+		return nil, err
+	}
+	if len(r.Results) == 0 {
+		return nil, fmt.Errorf("no policy returned in the response")
+	}
+	return &r.Results[0], nil
+}
+
+func (c *Client) UpdatePolicy(ctx context.Context, id string, p *Policy) (*Policy, error) {
+	builder := NewRequestBuilder(POST).WithContext(ctx)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/policies/"+id, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := builder.SetBodyContentJSON(&actionBody{Action: "edit", Resource: p}); err != nil {
+		return nil, err
+	}
+
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	u := &Policy{}
+	if err := json.Unmarshal(resp.RawResult, &u); err != nil {
+		return nil, err
+	}
+	return u, nil
+}
+
+func (c *Client) DeletePolicy(ctx context.Context, id string) (*ActionResult, error) {
+	return c.doAction(ctx, POST, c.Authenticator.GetBaseURL(), "/policies/"+id, "delete", nil)
+}
+
+func (c *Client) ListPolicyProfiles(queries url.Values) (*PolicyProfiles, error) {
+	builder := NewRequestBuilder(GET)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/policy_profiles", nil, queries)
+	if err != nil {
+		return nil, err
+	}
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	p := &PolicyProfiles{}
+	if err := json.Unmarshal(resp.RawResult, &p); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+func (c *Client) GetPolicyProfile(id string, queries url.Values) (*PolicyProfile, error) {
+	builder := NewRequestBuilder(GET)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/policy_profiles/"+id, nil, queries)
+	if err != nil {
+		return nil, err
+	}
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	p := &PolicyProfile{}
+	if err := json.Unmarshal(resp.RawResult, &p); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+func (c *Client) CreatePolicyProfile(ctx context.Context, p *PolicyProfile) (*PolicyProfile, error) {
+	builder := NewRequestBuilder(POST).WithContext(ctx)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/policy_profiles", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := builder.SetBodyContentJSON(&actionBody{Action: "create", Resource: p}); err != nil {
+		return nil, err
+	}
+
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	r := &policyProfileResults{}
+	if err := json.Unmarshal(resp.RawResult, &r); err != nil {
+		return nil, err
+	}
+	if len(r.Results) == 0 {
+		return nil, fmt.Errorf("no policy profile returned in the response")
+	}
+	return &r.Results[0], nil
+}
+
+func (c *Client) UpdatePolicyProfile(ctx context.Context, id string, p *PolicyProfile) (*PolicyProfile, error) {
+	builder := NewRequestBuilder(POST).WithContext(ctx)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/policy_profiles/"+id, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := builder.SetBodyContentJSON(&actionBody{Action: "edit", Resource: p}); err != nil {
+		return nil, err
+	}
+
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	u := &PolicyProfile{}
+	if err := json.Unmarshal(resp.RawResult, &u); err != nil {
+		return nil, err
+	}
+	return u, nil
+}
+
+func (c *Client) DeletePolicyProfile(ctx context.Context, id string) (*ActionResult, error) {
+	return c.doAction(ctx, POST, c.Authenticator.GetBaseURL(), "/policy_profiles/"+id, "delete", nil)
+}
+
+func (c *Client) ListConditions(queries url.Values) (*Conditions, error) {
+	builder := NewRequestBuilder(GET)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/conditions", nil, queries)
+	if err != nil {
+		return nil, err
+	}
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	cond := &Conditions{}
+	if err := json.Unmarshal(resp.RawResult, &cond); err != nil {
+		return nil, err
+	}
+	return cond, nil
+}
+
+func (c *Client) GetCondition(id string, queries url.Values) (*Condition, error) {
+	builder := NewRequestBuilder(GET)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/conditions/"+id, nil, queries)
+	if err != nil {
+		return nil, err
+	}
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	cond := &Condition{}
+	if err := json.Unmarshal(resp.RawResult, &cond); err != nil {
+		return nil, err
+	}
+	return cond, nil
+}
+
+func (c *Client) CreateCondition(ctx context.Context, cond *Condition) (*Condition, error) {
+	builder := NewRequestBuilder(POST).WithContext(ctx)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/conditions", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := builder.SetBodyContentJSON(&actionBody{Action: "create", Resource: cond}); err != nil {
+		return nil, err
+	}
+
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	r := &conditionResults{}
+	if err := json.Unmarshal(resp.RawResult, &r); err != nil {
+		return nil, err
+	}
+	if len(r.Results) == 0 {
+		return nil, fmt.Errorf("no condition returned in the response")
+	}
+	return &r.Results[0], nil
+}
+
+func (c *Client) UpdateCondition(ctx context.Context, id string, cond *Condition) (*Condition, error) {
+	builder := NewRequestBuilder(POST).WithContext(ctx)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/conditions/"+id, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := builder.SetBodyContentJSON(&actionBody{Action: "edit", Resource: cond}); err != nil {
+		return nil, err
+	}
+
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	u := &Condition{}
+	if err := json.Unmarshal(resp.RawResult, &u); err != nil {
+		return nil, err
+	}
+	return u, nil
+}
+
+func (c *Client) DeleteCondition(ctx context.Context, id string) (*ActionResult, error) {
+	return c.doAction(ctx, POST, c.Authenticator.GetBaseURL(), "/conditions/"+id, "delete", nil)
+}
+
+func (c *Client) ListPolicyActions(queries url.Values) (*PolicyActions, error) {
+	builder := NewRequestBuilder(GET)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/policy_actions", nil, queries)
+	if err != nil {
+		return nil, err
+	}
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	a := &PolicyActions{}
+	if err := json.Unmarshal(resp.RawResult, &a); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+func (c *Client) GetPolicyAction(id string, queries url.Values) (*PolicyAction, error) {
+	builder := NewRequestBuilder(GET)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/policy_actions/"+id, nil, queries)
+	if err != nil {
+		return nil, err
+	}
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	a := &PolicyAction{}
+	if err := json.Unmarshal(resp.RawResult, &a); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+func (c *Client) CreatePolicyAction(ctx context.Context, a *PolicyAction) (*PolicyAction, error) {
+	builder := NewRequestBuilder(POST).WithContext(ctx)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/policy_actions", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := builder.SetBodyContentJSON(&actionBody{Action: "create", Resource: a}); err != nil {
+		return nil, err
+	}
+
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	r := &policyActionResults{}
+	if err := json.Unmarshal(resp.RawResult, &r); err != nil {
+		return nil, err
+	}
+	if len(r.Results) == 0 {
+		return nil, fmt.Errorf("no policy action returned in the response")
+	}
+	return &r.Results[0], nil
+}
+
+func (c *Client) UpdatePolicyAction(ctx context.Context, id string, a *PolicyAction) (*PolicyAction, error) {
+	builder := NewRequestBuilder(POST).WithContext(ctx)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/policy_actions/"+id, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := builder.SetBodyContentJSON(&actionBody{Action: "edit", Resource: a}); err != nil {
+		return nil, err
+	}
+
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	u := &PolicyAction{}
+	if err := json.Unmarshal(resp.RawResult, &u); err != nil {
+		return nil, err
+	}
+	return u, nil
+}
+
+func (c *Client) DeletePolicyAction(ctx context.Context, id string) (*ActionResult, error) {
+	return c.doAction(ctx, POST, c.Authenticator.GetBaseURL(), "/policy_actions/"+id, "delete", nil)
+}
+
+type policyProfileAssignment struct {
+	Action    string        `json:"action"`
+	Resources []ResourceRef `json:"resources"`
+}
+
+// AssignPolicyProfiles assigns the policy profiles to the resource of the target collection, one of
+// PolicyTargetVMs, PolicyTargetHosts or PolicyTargetProviders.
+func (c *Client) AssignPolicyProfiles(ctx context.Context, target, id string, profileHrefs ...string) ([]ActionResult, error) {
+	return c.policyProfileAssignment(ctx, target, id, "assign", profileHrefs)
+}
+
+func (c *Client) UnassignPolicyProfiles(ctx context.Context, target, id string, profileHrefs ...string) ([]ActionResult, error) {
+	return c.policyProfileAssignment(ctx, target, id, "unassign", profileHrefs)
+}
+
+func (c *Client) policyProfileAssignment(ctx context.Context, target, id, action string, profileHrefs []string) ([]ActionResult, error) {
+	if len(profileHrefs) == 0 {
+		return nil, fmt.Errorf("no policy profile to %s", action)
+	}
+	body := &policyProfileAssignment{Action: action}
+	for _, href := range profileHrefs {
+		body.Resources = append(body.Resources, ResourceRef{Href: href})
+	}
+
+	builder := NewRequestBuilder(POST).WithContext(ctx)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/"+target+"/"+id+"/policy_profiles", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := builder.SetBodyContentJSON(body); err != nil {
+		return nil, err
+	}
+
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	r := &actionResults{}
+	if err := json.Unmarshal(resp.RawResult, &r); err != nil {
+		return nil, err
+	}
+	for _, result := range r.Results {
+		if !result.Success {
+			return r.Results, fmt.Errorf("failed to %s policy profile: %s", action, result.Message)
+		}
+	}
+	return r.Results, nil
+}
+
+// ListAssignedPolicyProfiles returns the policy profiles assigned to the resource of the target collection.
+func (c *Client) ListAssignedPolicyProfiles(target, id string, queries url.Values) (*PolicyProfiles, error) {
+	builder := NewRequestBuilder(GET)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/"+target+"/"+id+"/policy_profiles", nil, withList(queries, "expand", "resources"))
+	if err != nil {
+		return nil, err
+	}
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	p := &PolicyProfiles{}
+	if err := json.Unmarshal(resp.RawResult, &p); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// CheckCompliance queues the compliance check of the resource, the results are available with
+// GetCompliances once the returned task is finished.
+func (c *Client) CheckCompliance(ctx context.Context, target, id string) (*ActionResult, error) {
+	return c.doAction(ctx, POST, c.Authenticator.GetBaseURL(), "/"+target+"/"+id, "check_compliance", nil)
+}
+
+func (c *Client) GetCompliances(target, id string) ([]Compliance, error) {
+	builder := NewRequestBuilder(GET)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/"+target+"/"+id, nil, url.Values{"attributes": []string{"compliances"}})
+	if err != nil {
+		return nil, err
+	}
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	r := &struct {
+		Compliances []Compliance `json:"compliances"`
+	}{}
+	if err := json.Unmarshal(resp.RawResult, &r); err != nil {
+		return nil, err
+	}
+	return r.Compliances, nil
+}
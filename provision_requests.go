@@ -0,0 +1,289 @@
+package manageiq
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+const (
+	defaultProvisionRequestVersion = "1.1"
+	// Interval used by the WaitFor* methods when the interval given isn't positive
+	defaultWaitInterval = 10 * time.Second
+)
+
+type ProvisionRequests struct {
+	MangeIQListResource
+	Resources []ProvisionRequest `json:"resources"`
+}
+
+type ProvisionRequest struct {
+	Href          string                 `json:"href"`
+	ID            string                 `json:"id"`
+	Description   string                 `json:"description"`
+	Type          string                 `json:"type"`
+	RequestType   string                 `json:"request_type"`
+	ApprovalState string                 `json:"approval_state"`
+	RequestState  string                 `json:"request_state"`
+	Status        string                 `json:"status"`
+	Message       string                 `json:"message"`
+	UserID        string                 `json:"userid"`
+	SourceID      string                 `json:"source_id"`
+	SourceType    string                 `json:"source_type"`
+	CreatedOn     time.Time              `json:"created_on"`
+	UpdatedOn     time.Time              `json:"updated_on"`
+	FulfilledOn   time.Time              `json:"fulfilled_on"`
+	Options       map[string]interface{} `json:"options"`
+	Actions       []Action               `json:"actions"`
+	// Only populated when requested with attributes=miq_request_tasks
+	RequestTasks []RequestTask `json:"miq_request_tasks"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (p *ProvisionRequest) UnmarshalJSON(b []byte) error {
+	type alias ProvisionRequest
+	extra, err := unmarshalWithExtra(b, (*alias)(p))
+	if err != nil {
+		return err
+	}
+	p.Extra = extra
+	return nil
+}
+
+func (p ProvisionRequest) MarshalJSON() ([]byte, error) {
+	type alias ProvisionRequest
+	return marshalWithExtra(alias(p), p.Extra)
+}
+
+type RequestTask struct {
+	Href            string                 `json:"href"`
+	ID              string                 `json:"id"`
+	Description     string                 `json:"description"`
+	Type            string                 `json:"type"`
+	RequestType     string                 `json:"request_type"`
+	State           string                 `json:"state"`
+	Status          string                 `json:"status"`
+	Message         string                 `json:"message"`
+	UserID          string                 `json:"userid"`
+	MiqRequestID    string                 `json:"miq_request_id"`
+	SourceID        string                 `json:"source_id"`
+	SourceType      string                 `json:"source_type"`
+	DestinationID   string                 `json:"destination_id"`
+	DestinationType string                 `json:"destination_type"`
+	CreatedOn       time.Time              `json:"created_on"`
+	UpdatedOn       time.Time              `json:"updated_on"`
+	Options         map[string]interface{} `json:"options"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (r *RequestTask) UnmarshalJSON(b []byte) error {
+	type alias RequestTask
+	extra, err := unmarshalWithExtra(b, (*alias)(r))
+	if err != nil {
+		return err
+	}
+	r.Extra = extra
+	return nil
+}
+
+func (r RequestTask) MarshalJSON() ([]byte, error) {
+	type alias RequestTask
+	return marshalWithExtra(alias(r), r.Extra)
+}
+
+// ProvisionRequestParams is the body used for creating a provision request, any option
+// not covered by the typed fields can be passed via the Extra maps.
+type ProvisionRequestParams struct {
+	Version             string                 `json:"version"`
+	TemplateFields      TemplateFields         `json:"template_fields"`
+	VMFields            VMFields               `json:"vm_fields"`
+	Requester           Requester              `json:"requester"`
+	Tags                map[string]interface{} `json:"tags,omitempty"`
+	AdditionalValues    map[string]interface{} `json:"additional_values,omitempty"`
+	EMSCustomAttributes map[string]interface{} `json:"ems_custom_attributes,omitempty"`
+	MIQCustomAttributes map[string]interface{} `json:"miq_custom_attributes,omitempty"`
+}
+
+type TemplateFields struct {
+	GUID        string `json:"guid,omitempty"`
+	Name        string `json:"name,omitempty"`
+	RequestType string `json:"request_type,omitempty"`
+
+	Extra map[string]interface{} `json:"-"`
+}
+
+func (t TemplateFields) MarshalJSON() ([]byte, error) {
+	type alias TemplateFields
+	return marshalWithExtra(alias(t), t.Extra)
+}
+
+type VMFields struct {
+	VMName          string `json:"vm_name,omitempty"`
+	VMDescription   string `json:"vm_description,omitempty"`
+	NumberOfVMs     int    `json:"number_of_vms,omitempty"`
+	NumberOfCPUs    int    `json:"number_of_cpus,omitempty"`
+	NumberOfSockets int    `json:"number_of_sockets,omitempty"`
+	CoresPerSocket  int    `json:"cores_per_socket,omitempty"`
+	// Memory in MB
+	VMMemory      string `json:"vm_memory,omitempty"`
+	VLAN          string `json:"vlan,omitempty"`
+	InstanceType  string `json:"instance_type,omitempty"`
+	ProvisionType string `json:"provision_type,omitempty"`
+	PlacementAuto bool   `json:"placement_auto,omitempty"`
+
+	Extra map[string]interface{} `json:"-"`
+}
+
+func (v VMFields) MarshalJSON() ([]byte, error) {
+	type alias VMFields
+	return marshalWithExtra(alias(v), v.Extra)
+}
+
+type Requester struct {
+	UserName       string `json:"user_name,omitempty"`
+	OwnerFirstName string `json:"owner_first_name,omitempty"`
+	OwnerLastName  string `json:"owner_last_name,omitempty"`
+	OwnerEmail     string `json:"owner_email,omitempty"`
+	RequestNotes   string `json:"request_notes,omitempty"`
+	AutoApprove    bool   `json:"auto_approve,omitempty"`
+
+	Extra map[string]interface{} `json:"-"`
+}
+
+func (r Requester) MarshalJSON() ([]byte, error) {
+	type alias Requester
+	return marshalWithExtra(alias(r), r.Extra)
+}
+
+type provisionRequestResults struct {
+	Results []ProvisionRequest `json:"results"`
+}
+
+func (c *Client) CreateProvisionRequest(ctx context.Context, params *ProvisionRequestParams) (*ProvisionRequest, error) {
+	if params == nil {
+		return nil, fmt.Errorf("params can't be nil")
+	}
+	body := *params
+	if body.Version == "" {
+		body.Version = defaultProvisionRequestVersion
+	}
+	builder := NewRequestBuilder(POST).WithContext(ctx)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/provision_requests", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := builder.SetBodyContentJSON(&body); err != nil {
+		return nil, err
+	}
+
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	r := &provisionRequestResults{}
+	if err := json.Unmarshal(resp.RawResult, &r); err != nil {
+		return nil, err
+	}
+	if len(r.Results) == 0 {
+		return nil, fmt.Errorf("no provision request returned in the response")
+	}
+	return &r.Results[0], nil
+}
+
+func (c *Client) ListProvisionRequests(queries url.Values) (*ProvisionRequests, error) {
+	builder := NewRequestBuilder(GET)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/provision_requests", nil, queries)
+	if err != nil {
+		return nil, err
+	}
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	p := &ProvisionRequests{}
+	if err := json.Unmarshal(resp.RawResult, &p); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+func (c *Client) GetProvisionRequest(id string, queries url.Values) (*ProvisionRequest, error) {
+	return c.getProvisionRequest(context.Background(), id, queries)
+}
+
+func (c *Client) getProvisionRequest(ctx context.Context, id string, queries url.Values) (*ProvisionRequest, error) {
+	builder := NewRequestBuilder(GET).WithContext(ctx)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/provision_requests/"+id, nil, queries)
+	if err != nil {
+		return nil, err
+	}
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	p := &ProvisionRequest{}
+	if err := json.Unmarshal(resp.RawResult, &p); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// WaitForProvisionRequestVMs polls the provision request every interval until it is finished and
+// returns the VMs provisioned by its tasks. The default interval is used if interval isn't positive.
+func (c *Client) WaitForProvisionRequestVMs(ctx context.Context, id string, interval time.Duration) ([]VM, error) {
+	if interval <= 0 {
+		interval = defaultWaitInterval
+	}
+	queries := url.Values{"attributes": []string{"miq_request_tasks"}}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		p, err := c.getProvisionRequest(ctx, id, queries)
+		if err != nil {
+			return nil, err
+		}
+		if p.RequestState == RequestStateFinished {
+			if p.Status == RequestStatusError {
+				return nil, fmt.Errorf("provision request %s failed: %s", id, p.Message)
+			}
+			var vms []VM
+			for _, task := range p.RequestTasks {
+				if task.DestinationID == "" {
+					continue
+				}
+				vm, err := c.getVM(ctx, task.DestinationID, nil)
+				if err != nil {
+					return nil, err
+				}
+				vms = append(vms, *vm)
+			}
+			return vms, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
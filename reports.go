@@ -0,0 +1,336 @@
+package manageiq
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+const reportResultsPageSize = 100
+
+type Reports struct {
+	MangeIQListResource
+	Resources []Report `json:"resources"`
+}
+
+type Report struct {
+	Href         string    `json:"href"`
+	ID           string    `json:"id"`
+	Name         string    `json:"name"`
+	Title        string    `json:"title"`
+	RptGroup     string    `json:"rpt_group"`
+	RptType      string    `json:"rpt_type"`
+	DB           string    `json:"db"`
+	Cols         []string  `json:"cols"`
+	ColOrder     []string  `json:"col_order"`
+	Headers      []string  `json:"headers"`
+	SortBy       []string  `json:"sortby"`
+	TemplateType string    `json:"template_type"`
+	CreatedOn    time.Time `json:"created_on"`
+	UpdatedOn    time.Time `json:"updated_on"`
+	Actions      []Action  `json:"actions"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (r *Report) UnmarshalJSON(b []byte) error {
+	type alias Report
+	extra, err := unmarshalWithExtra(b, (*alias)(r))
+	if err != nil {
+		return err
+	}
+	r.Extra = extra
+	return nil
+}
+
+func (r Report) MarshalJSON() ([]byte, error) {
+	type alias Report
+	return marshalWithExtra(alias(r), r.Extra)
+}
+
+type ReportResults struct {
+	MangeIQListResource
+	Resources []ReportResult `json:"resources"`
+}
+
+type ReportResult struct {
+	Href           string    `json:"href"`
+	ID             string    `json:"id"`
+	Name           string    `json:"name"`
+	ReportSource   string    `json:"report_source"`
+	MiqReportID    string    `json:"miq_report_id"`
+	MiqTaskID      string    `json:"miq_task_id"`
+	UserID         string    `json:"userid"`
+	CreatedOn      time.Time `json:"created_on"`
+	LastRunOn      time.Time `json:"last_run_on"`
+	LastAccessedOn time.Time `json:"last_accessed_on"`
+	// Only populated when requested with attributes=result_set
+	ResultSet []ReportRow `json:"result_set"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (r *ReportResult) UnmarshalJSON(b []byte) error {
+	type alias ReportResult
+	extra, err := unmarshalWithExtra(b, (*alias)(r))
+	if err != nil {
+		return err
+	}
+	r.Extra = extra
+	return nil
+}
+
+func (r ReportResult) MarshalJSON() ([]byte, error) {
+	type alias ReportResult
+	return marshalWithExtra(alias(r), r.Extra)
+}
+
+// ReportRow is a row of a report result keyed by the column names of the report.
+type ReportRow map[string]interface{}
+
+// String returns the value of the column as a string, empty if the column isn't set.
+func (r ReportRow) String(col string) string {
+	v, ok := r[col]
+	if !ok || v == nil {
+		return ""
+	}
+	if s, ok := v.(string); ok {
+		return s
+	}
+	return fmt.Sprint(v)
+}
+
+// Float returns the numeric value of the column, false if the column isn't a number.
+func (r ReportRow) Float(col string) (float64, bool) {
+	switch v := r[col].(type) {
+	case float64:
+		return v, true
+	case string:
+		f, err := strconv.ParseFloat(v, 64)
+		return f, err == nil
+	}
+	return 0, false
+}
+
+// Int returns the integer value of the column, false if the column isn't an integer.
+func (r ReportRow) Int(col string) (int64, bool) {
+	if s, ok := r[col].(string); ok {
+		i, err := strconv.ParseInt(s, 10, 64)
+		return i, err == nil
+	}
+	f, ok := r.Float(col)
+	if !ok || f != math.Trunc(f) {
+		return 0, false
+	}
+	return int64(f), true
+}
+
+func (r ReportRow) Bool(col string) (bool, bool) {
+	switch v := r[col].(type) {
+	case bool:
+		return v, true
+	case string:
+		b, err := strconv.ParseBool(v)
+		return b, err == nil
+	}
+	return false, false
+}
+
+// Time returns the value of a timestamp column, false if the column can't be parsed as a timestamp.
+func (r ReportRow) Time(col string) (time.Time, bool) {
+	s, ok := r[col].(string)
+	if !ok {
+		return time.Time{}, false
+	}
+	return parseTime(s)
+}
+
+// Rows returns the rows of the result set as values ordered by the given columns, e.g. the ColOrder of the report.
+func (r *ReportResult) Rows(cols []string) [][]interface{} {
+	rows := make([][]interface{}, 0, len(r.ResultSet))
+	for _, row := range r.ResultSet {
+		values := make([]interface{}, len(cols))
+		for i, col := range cols {
+			values[i] = row[col]
+		}
+		rows = append(rows, values)
+	}
+	return rows
+}
+
+// ReportRunResult is returned when running a report, the result is available once the task is finished.
+type ReportRunResult struct {
+	ActionResult
+	ResultID   string `json:"result_id"`
+	ResultHref string `json:"result_href"`
+}
+
+type ReportScheduleParams struct {
+	Name        string
+	Description string
+	// The schedule is enabled by ManageIQ when Enabled isn't set
+	Enabled *bool
+	RunAt   RunAt
+}
+
+type reportScheduleBody struct {
+	Name        string             `json:"name"`
+	Description string             `json:"description"`
+	Enabled     *bool              `json:"enabled,omitempty"`
+	RunAt       reportScheduleTime `json:"run_at"`
+}
+
+// reportScheduleTime is the run_at of the report schedule action, which reads the time zone from
+// time_zone rather than the tz used by the schedules.
+type reportScheduleTime struct {
+	StartTime string        `json:"start_time"`
+	TimeZone  string        `json:"time_zone,omitempty"`
+	Interval  intervalParam `json:"interval"`
+}
+
+func (c *Client) ListReports(queries url.Values) (*Reports, error) {
+	builder := NewRequestBuilder(GET)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/reports", nil, queries)
+	if err != nil {
+		return nil, err
+	}
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	r := &Reports{}
+	if err := json.Unmarshal(resp.RawResult, &r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (c *Client) GetReport(id string, queries url.Values) (*Report, error) {
+	builder := NewRequestBuilder(GET)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/reports/"+id, nil, queries)
+	if err != nil {
+		return nil, err
+	}
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	r := &Report{}
+	if err := json.Unmarshal(resp.RawResult, &r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (c *Client) RunReport(ctx context.Context, id string) (*ReportRunResult, error) {
+	r := &ReportRunResult{}
+	if _, err := c.doActionInto(ctx, POST, c.Authenticator.GetBaseURL(), "/reports/"+id, "run", nil, r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (c *Client) ScheduleReport(ctx context.Context, id string, params *ReportScheduleParams) (*ActionResult, error) {
+	if params == nil {
+		return nil, fmt.Errorf("params can't be nil")
+	}
+	body := &reportScheduleBody{
+		Name:        params.Name,
+		Description: params.Description,
+		Enabled:     params.Enabled,
+		RunAt: reportScheduleTime{
+			StartTime: params.RunAt.StartTime.UTC().Format(runAtTimeFormat),
+			TimeZone:  params.RunAt.TimeZone,
+			Interval:  intervalParam{Unit: params.RunAt.IntervalUnit},
+		},
+	}
+	if params.RunAt.IntervalValue > 0 {
+		body.RunAt.Interval.Value = strconv.Itoa(params.RunAt.IntervalValue)
+	}
+	return c.doAction(ctx, POST, c.Authenticator.GetBaseURL(), "/reports/"+id, "schedule", body)
+}
+
+// GetReportResult returns the report result along with its result set.
+func (c *Client) GetReportResult(id string, queries url.Values) (*ReportResult, error) {
+	builder := NewRequestBuilder(GET)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/results/"+id, nil, withList(queries, "attributes", "result_set"))
+	if err != nil {
+		return nil, err
+	}
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	r := &ReportResult{}
+	if err := json.Unmarshal(resp.RawResult, &r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (c *Client) ListReportResults(reportID string, queries url.Values) (*ReportResults, error) {
+	builder := NewRequestBuilder(GET)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/reports/"+reportID+"/results", nil, queries)
+	if err != nil {
+		return nil, err
+	}
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	r := &ReportResults{}
+	if err := json.Unmarshal(resp.RawResult, &r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// EachReportResult calls fn for every result of the report, most recent first, fetching the results
+// page by page. Iteration stops on the first error returned by fn.
+func (c *Client) EachReportResult(reportID string, fn func(*ReportResult) error) error {
+	for offset := 0; ; offset += reportResultsPageSize {
+		queries := url.Values{
+			"expand":     []string{"resources"},
+			"sort_by":    []string{"created_on"},
+			"sort_order": []string{"desc"},
+			"offset":     []string{strconv.Itoa(offset)},
+			"limit":      []string{strconv.Itoa(reportResultsPageSize)},
+		}
+		page, err := c.ListReportResults(reportID, queries)
+		if err != nil {
+			return err
+		}
+		for i := range page.Resources {
+			if err := fn(&page.Resources[i]); err != nil {
+				return err
+			}
+		}
+		if len(page.Resources) < reportResultsPageSize {
+			return nil
+		}
+	}
+}
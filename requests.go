@@ -0,0 +1,252 @@
+package manageiq
+
+import (
+	"encoding/json"
+	"net/url"
+	"time"
+)
+
+const (
+	RequestStatePending  = "pending"
+	RequestStateQueued   = "queued"
+	RequestStateActive   = "active"
+	RequestStateFinished = "finished"
+
+	RequestStatusOk    = "Ok"
+	RequestStatusWarn  = "Warn"
+	RequestStatusError = "Error"
+
+	ApprovalStatePendingApproval = "pending_approval"
+	ApprovalStateApproved        = "approved"
+	ApprovalStateDenied          = "denied"
+
+	RequestTypeProvision        = "MiqProvisionRequest"
+	RequestTypeAutomation       = "AutomationRequest"
+	RequestTypeServiceProvision = "ServiceTemplateProvisionRequest"
+	RequestTypeServiceRetire    = "ServiceRetireRequest"
+	RequestTypeVMRetire         = "VmRetireRequest"
+	RequestTypeVMReconfigure    = "VmReconfigureRequest"
+	RequestTypeVMMigrate        = "VmMigrateRequest"
+)
+
+type Requests struct {
+	MangeIQListResource
+	Resources []Request `json:"resources"`
+}
+
+// Request holds the attributes common to all the request types, the model specific to the request
+// type can be obtained with Resolve.
+type Request struct {
+	Href          string                 `json:"href"`
+	ID            string                 `json:"id"`
+	Description   string                 `json:"description"`
+	Type          string                 `json:"type"`
+	RequestType   string                 `json:"request_type"`
+	ApprovalState string                 `json:"approval_state"`
+	RequestState  string                 `json:"request_state"`
+	Status        string                 `json:"status"`
+	Message       string                 `json:"message"`
+	UserID        string                 `json:"userid"`
+	SourceID      string                 `json:"source_id"`
+	SourceType    string                 `json:"source_type"`
+	CreatedOn     time.Time              `json:"created_on"`
+	UpdatedOn     time.Time              `json:"updated_on"`
+	FulfilledOn   time.Time              `json:"fulfilled_on"`
+	Options       map[string]interface{} `json:"options"`
+	Actions       []Action               `json:"actions"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+	raw   json.RawMessage
+}
+
+func (r *Request) UnmarshalJSON(b []byte) error {
+	type alias Request
+	extra, err := unmarshalWithExtra(b, (*alias)(r))
+	if err != nil {
+		return err
+	}
+	r.Extra = extra
+	r.raw = append(json.RawMessage(nil), b...)
+	return nil
+}
+
+func (r Request) MarshalJSON() ([]byte, error) {
+	type alias Request
+	return marshalWithExtra(alias(r), r.Extra)
+}
+
+// Resolve returns the model matching the type of the request, *ProvisionRequest for MiqProvisionRequest,
+// *AutomationRequest for AutomationRequest. The *Request itself is returned for the other types, which
+// have no dedicated model. The model is decoded from the JSON the request was read from, or from the
+// request re-marshalled when it wasn't read from JSON.
+func (r *Request) Resolve() (interface{}, error) {
+	var v interface{}
+	switch r.Type {
+	case RequestTypeProvision:
+		v = &ProvisionRequest{}
+	case RequestTypeAutomation:
+		v = &AutomationRequest{}
+	default:
+		return r, nil
+	}
+	raw := r.raw
+	if len(raw) == 0 {
+		b, err := json.Marshal(r)
+		if err != nil {
+			return nil, err
+		}
+		raw = b
+	}
+	if err := json.Unmarshal(raw, v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// AsProvisionRequest returns the request as a *ProvisionRequest, false if the request isn't a
+// MiqProvisionRequest or can't be decoded as one.
+func (r *Request) AsProvisionRequest() (*ProvisionRequest, bool) {
+	if r.Type != RequestTypeProvision {
+		return nil, false
+	}
+	v, err := r.Resolve()
+	if err != nil {
+		return nil, false
+	}
+	p, ok := v.(*ProvisionRequest)
+	return p, ok
+}
+
+// AsAutomationRequest returns the request as an *AutomationRequest, false if the request isn't an
+// AutomationRequest or can't be decoded as one.
+func (r *Request) AsAutomationRequest() (*AutomationRequest, bool) {
+	if r.Type != RequestTypeAutomation {
+		return nil, false
+	}
+	v, err := r.Resolve()
+	if err != nil {
+		return nil, false
+	}
+	a, ok := v.(*AutomationRequest)
+	return a, ok
+}
+
+// RequestFilter narrows the requests returned by ListRequests.
+type RequestFilter struct {
+	Type          string
+	RequestState  string
+	ApprovalState string
+}
+
+// queries returns the queries merged with the filter[] entries of the filter.
+func (f *RequestFilter) queries(queries url.Values) url.Values {
+	queries = withList(queries, "expand", "resources")
+	if f == nil {
+		return queries
+	}
+	if f.Type != "" {
+		queries.Add("filter[]", "type="+f.Type)
+	}
+	if f.RequestState != "" {
+		queries.Add("filter[]", "request_state="+f.RequestState)
+	}
+	if f.ApprovalState != "" {
+		queries.Add("filter[]", "approval_state="+f.ApprovalState)
+	}
+	return queries
+}
+
+type RequestTasks struct {
+	MangeIQListResource
+	Resources []RequestTask `json:"resources"`
+}
+
+// ListRequests returns the requests matching the filter, the filter is merged into the queries.
+func (c *Client) ListRequests(filter *RequestFilter, queries url.Values) (*Requests, error) {
+	builder := NewRequestBuilder(GET)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/requests", nil, filter.queries(queries))
+	if err != nil {
+		return nil, err
+	}
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	r := &Requests{}
+	if err := json.Unmarshal(resp.RawResult, &r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (c *Client) GetRequest(id string, queries url.Values) (*Request, error) {
+	builder := NewRequestBuilder(GET)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/requests/"+id, nil, queries)
+	if err != nil {
+		return nil, err
+	}
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	r := &Request{}
+	if err := json.Unmarshal(resp.RawResult, &r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ListRequestTasks returns the tasks of the request along with their state and message.
+func (c *Client) ListRequestTasks(requestID string, queries url.Values) (*RequestTasks, error) {
+	builder := NewRequestBuilder(GET)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/requests/"+requestID+"/request_tasks", nil, withList(queries, "expand", "resources"))
+	if err != nil {
+		return nil, err
+	}
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	t := &RequestTasks{}
+	if err := json.Unmarshal(resp.RawResult, &t); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+func (c *Client) GetRequestTask(requestID, id string, queries url.Values) (*RequestTask, error) {
+	builder := NewRequestBuilder(GET)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/requests/"+requestID+"/request_tasks/"+id, nil, queries)
+	if err != nil {
+		return nil, err
+	}
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	t := &RequestTask{}
+	if err := json.Unmarshal(resp.RawResult, &t); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
@@ -0,0 +1,110 @@
+package manageiq
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+)
+
+func TestRequestResolve(t *testing.T) {
+	tests := []struct {
+		name     string
+		request  func() *Request
+		wantType string
+		wantID   string
+		wantTask string
+	}{
+		{
+			name:     "provision request",
+			request:  func() *Request { return unmarshalRequest(t, RequestTypeProvision) },
+			wantType: "*manageiq.ProvisionRequest",
+			wantID:   "10",
+			wantTask: "11",
+		},
+		{
+			name:     "automation request",
+			request:  func() *Request { return unmarshalRequest(t, RequestTypeAutomation) },
+			wantType: "*manageiq.AutomationRequest",
+			wantID:   "10",
+			wantTask: "11",
+		},
+		{
+			name:     "request without a dedicated model",
+			request:  func() *Request { return unmarshalRequest(t, RequestTypeVMRetire) },
+			wantType: "*manageiq.Request",
+			wantID:   "10",
+		},
+		{
+			name: "request not read from JSON",
+			request: func() *Request {
+				return &Request{ID: "12", Type: RequestTypeProvision, Extra: map[string]json.RawMessage{
+					"miq_request_tasks": json.RawMessage(`[{"id": "13"}]`),
+				}}
+			},
+			wantType: "*manageiq.ProvisionRequest",
+			wantID:   "12",
+			wantTask: "13",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := tt.request()
+			v, err := r.Resolve()
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			var id, task string
+			switch v := v.(type) {
+			case *ProvisionRequest:
+				id = v.ID
+				if len(v.RequestTasks) > 0 {
+					task = v.RequestTasks[0].ID
+				}
+				if _, ok := r.AsProvisionRequest(); !ok {
+					t.Errorf("AsProvisionRequest failed")
+				}
+				if _, ok := r.AsAutomationRequest(); ok {
+					t.Errorf("AsAutomationRequest succeeded for a provision request")
+				}
+			case *AutomationRequest:
+				id = v.ID
+				if len(v.RequestTasks) > 0 {
+					task = v.RequestTasks[0].ID
+				}
+				if _, ok := r.AsAutomationRequest(); !ok {
+					t.Errorf("AsAutomationRequest failed")
+				}
+				if _, ok := r.AsProvisionRequest(); ok {
+					t.Errorf("AsProvisionRequest succeeded for an automation request")
+				}
+			case *Request:
+				id = v.ID
+				if v != r {
+					t.Errorf("expected the request itself")
+				}
+				if _, ok := r.AsProvisionRequest(); ok {
+					t.Errorf("AsProvisionRequest succeeded for a %s", r.Type)
+				}
+			}
+			if got := fmt.Sprintf("%T", v); got != tt.wantType {
+				t.Errorf("got %s, want %s", got, tt.wantType)
+			}
+			if id != tt.wantID {
+				t.Errorf("got id %s, want %s", id, tt.wantID)
+			}
+			if task != tt.wantTask {
+				t.Errorf("got task %s, want %s", task, tt.wantTask)
+			}
+		})
+	}
+}
+
+func unmarshalRequest(t *testing.T, requestType string) *Request {
+	t.Helper()
+	b := []byte(`{"id": "10", "type": "` + requestType + `", "request_state": "finished", "miq_request_tasks": [{"id": "11"}]}`)
+	r := &Request{}
+	if err := json.Unmarshal(b, r); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return r
+}
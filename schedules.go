@@ -0,0 +1,294 @@
+package manageiq
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+const (
+	IntervalOnce    = "once"
+	IntervalHourly  = "hourly"
+	IntervalDaily   = "daily"
+	IntervalWeekly  = "weekly"
+	IntervalMonthly = "monthly"
+
+	ScheduleResourceVM                = "Vm"
+	ScheduleResourceHost              = "Host"
+	ScheduleResourceReport            = "MiqReport"
+	ScheduleResourceAutomationRequest = "AutomationRequest"
+
+	ScheduleActionScan              = "vm_scan"
+	ScheduleActionCheckCompliance   = "check_compliance"
+	ScheduleActionRunReport         = "run_report"
+	ScheduleActionAutomationRequest = "automation_request"
+
+	runAtTimeFormat = "2006-01-02 15:04:05 UTC"
+)
+
+// RunAt defines when and how often a scheduled action is run.
+type RunAt struct {
+	StartTime time.Time
+	TimeZone  string
+	// One of IntervalOnce, IntervalHourly, IntervalDaily, IntervalWeekly or IntervalMonthly
+	IntervalUnit  string
+	IntervalValue int
+}
+
+type runAtJSON struct {
+	StartTime string        `json:"start_time"`
+	TimeZone  string        `json:"tz,omitempty"`
+	Interval  intervalParam `json:"interval"`
+}
+
+type intervalParam struct {
+	Unit  string `json:"unit"`
+	Value string `json:"value,omitempty"`
+}
+
+func (r RunAt) MarshalJSON() ([]byte, error) {
+	j := runAtJSON{
+		StartTime: r.StartTime.UTC().Format(runAtTimeFormat),
+		TimeZone:  r.TimeZone,
+		Interval:  intervalParam{Unit: r.IntervalUnit},
+	}
+	if r.IntervalValue > 0 {
+		j.Interval.Value = strconv.Itoa(r.IntervalValue)
+	}
+	return json.Marshal(j)
+}
+
+func (r *RunAt) UnmarshalJSON(b []byte) error {
+	j := &struct {
+		StartTime string `json:"start_time"`
+		TimeZone  string `json:"tz"`
+		Interval  struct {
+			Unit  string          `json:"unit"`
+			Value json.RawMessage `json:"value"`
+		} `json:"interval"`
+	}{}
+	if err := json.Unmarshal(b, j); err != nil {
+		return err
+	}
+	*r = RunAt{TimeZone: j.TimeZone, IntervalUnit: j.Interval.Unit}
+	if j.StartTime != "" {
+		t, ok := parseTime(j.StartTime)
+		if !ok {
+			return fmt.Errorf("error parsing run_at start_time '%s'", j.StartTime)
+		}
+		r.StartTime = t
+	}
+	// The interval value is sent as a string but can be returned as a number.
+	if len(j.Interval.Value) > 0 && string(j.Interval.Value) != "null" {
+		var s string
+		if err := json.Unmarshal(j.Interval.Value, &s); err != nil {
+			s = string(j.Interval.Value)
+		}
+		if s != "" {
+			v, err := strconv.Atoi(s)
+			if err != nil {
+				return fmt.Errorf("error parsing run_at interval value: %s", err.Error())
+			}
+			r.IntervalValue = v
+		}
+	}
+	return nil
+}
+
+type Schedules struct {
+	MangeIQListResource
+	Resources []Schedule `json:"resources"`
+}
+
+type Schedule struct {
+	Href         string `json:"href,omitempty"`
+	ID           string `json:"id,omitempty"`
+	Name         string `json:"name,omitempty"`
+	Description  string `json:"description,omitempty"`
+	Enabled      *bool  `json:"enabled,omitempty"`
+	ResourceType string `json:"resource_type,omitempty"`
+	// Action run on the targets, e.g. {"method": "check_compliance"}
+	SchedAction map[string]interface{} `json:"sched_action,omitempty"`
+	// Filter selecting the targets of the action, an expression for vms, hosts and reports or the
+	// automate entry point for automation requests.
+	Filter    map[string]interface{} `json:"filter,omitempty"`
+	RunAt     *RunAt                 `json:"run_at,omitempty"`
+	UserID    string                 `json:"userid,omitempty"`
+	ZoneID    string                 `json:"zone_id,omitempty"`
+	LastRunOn time.Time              `json:"last_run_on"`
+	NextRunOn time.Time              `json:"next_run_on"`
+	CreatedOn time.Time              `json:"created_on"`
+	UpdatedAt time.Time              `json:"updated_at"`
+	Actions   []Action               `json:"actions,omitempty"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (s *Schedule) UnmarshalJSON(b []byte) error {
+	type alias Schedule
+	extra, err := unmarshalWithExtra(b, (*alias)(s))
+	if err != nil {
+		return err
+	}
+	s.Extra = extra
+	return nil
+}
+
+func (s Schedule) MarshalJSON() ([]byte, error) {
+	type alias Schedule
+	return marshalWithExtra(alias(s), s.Extra)
+}
+
+// NewComplianceSchedule returns a schedule checking the compliance of all the vms or hosts.
+func NewComplianceSchedule(name, resourceType string, runAt RunAt) *Schedule {
+	enabled := true
+	return &Schedule{
+		Name:         name,
+		Description:  name,
+		Enabled:      &enabled,
+		ResourceType: resourceType,
+		SchedAction:  map[string]interface{}{"method": ScheduleActionCheckCompliance},
+		Filter:       map[string]interface{}{"exp": map[string]interface{}{"IS NOT NULL": map[string]interface{}{"field": resourceType + "-name"}}},
+		RunAt:        &runAt,
+	}
+}
+
+// NewReportSchedule returns a schedule running the report.
+func NewReportSchedule(name, reportID string, runAt RunAt) *Schedule {
+	enabled := true
+	return &Schedule{
+		Name:         name,
+		Description:  name,
+		Enabled:      &enabled,
+		ResourceType: ScheduleResourceReport,
+		SchedAction:  map[string]interface{}{"method": ScheduleActionRunReport},
+		Filter:       map[string]interface{}{"exp": map[string]interface{}{"=": map[string]interface{}{"field": "MiqReport-id", "value": reportID}}},
+		RunAt:        &runAt,
+	}
+}
+
+// NewAutomationSchedule returns a schedule invoking the Automate entry point with the parameters.
+func NewAutomationSchedule(name string, uri AutomationURI, params map[string]interface{}, runAt RunAt) *Schedule {
+	enabled := true
+	return &Schedule{
+		Name:         name,
+		Description:  name,
+		Enabled:      &enabled,
+		ResourceType: ScheduleResourceAutomationRequest,
+		SchedAction:  map[string]interface{}{"method": ScheduleActionAutomationRequest},
+		Filter:       map[string]interface{}{"uri_parts": uri, "parameters": params},
+		RunAt:        &runAt,
+	}
+}
+
+type scheduleResults struct {
+	Results []Schedule `json:"results"`
+}
+
+func (c *Client) ListSchedules(queries url.Values) (*Schedules, error) {
+	builder := NewRequestBuilder(GET)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/schedules", nil, queries)
+	if err != nil {
+		return nil, err
+	}
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	s := &Schedules{}
+	if err := json.Unmarshal(resp.RawResult, &s); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (c *Client) GetSchedule(id string, queries url.Values) (*Schedule, error) {
+	builder := NewRequestBuilder(GET)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/schedules/"+id, nil, queries)
+	if err != nil {
+		return nil, err
+	}
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	s := &Schedule{}
+	if err := json.Unmarshal(resp.RawResult, &s); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (c *Client) CreateSchedule(ctx context.Context, s *Schedule) (*Schedule, error) {
+	builder := NewRequestBuilder(POST).WithContext(ctx)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/schedules", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := builder.SetBodyContentJSON(&actionBody{Action: "create", Resource: s}); err != nil {
+		return nil, err
+	}
+
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	r := &scheduleResults{}
+	if err := json.Unmarshal(resp.RawResult, &r); err != nil {
+		return nil, err
+	}
+	if len(r.Results) == 0 {
+		return nil, fmt.Errorf("no schedule returned in the response")
+	}
+	return &r.Results[0], nil
+}
+
+func (c *Client) UpdateSchedule(ctx context.Context, id string, s *Schedule) (*Schedule, error) {
+	builder := NewRequestBuilder(POST).WithContext(ctx)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/schedules/"+id, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := builder.SetBodyContentJSON(&actionBody{Action: "edit", Resource: s}); err != nil {
+		return nil, err
+	}
+
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	u := &Schedule{}
+	if err := json.Unmarshal(resp.RawResult, &u); err != nil {
+		return nil, err
+	}
+	return u, nil
+}
+
+func (c *Client) DeleteSchedule(ctx context.Context, id string) (*ActionResult, error) {
+	return c.doAction(ctx, POST, c.Authenticator.GetBaseURL(), "/schedules/"+id, "delete", nil)
+}
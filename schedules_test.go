@@ -0,0 +1,119 @@
+package manageiq
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestRunAtRoundTrip(t *testing.T) {
+	tests := []struct {
+		name  string
+		runAt RunAt
+		json  string
+	}{
+		{
+			name:  "once",
+			runAt: RunAt{StartTime: time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC), IntervalUnit: IntervalOnce},
+			json:  `{"start_time":"2024-05-01 10:00:00 UTC","interval":{"unit":"once"}}`,
+		},
+		{
+			name: "daily with time zone",
+			runAt: RunAt{
+				StartTime:     time.Date(2024, 5, 1, 10, 30, 0, 0, time.UTC),
+				TimeZone:      "Europe/Paris",
+				IntervalUnit:  IntervalDaily,
+				IntervalValue: 2,
+			},
+			json: `{"start_time":"2024-05-01 10:30:00 UTC","tz":"Europe/Paris","interval":{"unit":"daily","value":"2"}}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, err := json.Marshal(tt.runAt)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if string(b) != tt.json {
+				t.Errorf("got %s, want %s", b, tt.json)
+			}
+			got := RunAt{}
+			if err := json.Unmarshal(b, &got); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.runAt) {
+				t.Errorf("got %+v, want %+v", got, tt.runAt)
+			}
+		})
+	}
+}
+
+func TestRunAtUnmarshal(t *testing.T) {
+	start := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name    string
+		json    string
+		want    RunAt
+		wantErr bool
+	}{
+		{
+			name: "string interval value",
+			json: `{"start_time":"2024-05-01 10:00:00 UTC","interval":{"unit":"hourly","value":"4"}}`,
+			want: RunAt{StartTime: start, IntervalUnit: IntervalHourly, IntervalValue: 4},
+		},
+		{
+			name: "numeric interval value",
+			json: `{"start_time":"2024-05-01T10:00:00Z","interval":{"unit":"weekly","value":1}}`,
+			want: RunAt{StartTime: start, IntervalUnit: IntervalWeekly, IntervalValue: 1},
+		},
+		{
+			name: "null interval value",
+			json: `{"start_time":"2024-05-01T10:00:00Z","interval":{"unit":"once","value":null}}`,
+			want: RunAt{StartTime: start, IntervalUnit: IntervalOnce},
+		},
+		{
+			name: "empty interval value",
+			json: `{"start_time":"2024-05-01T10:00:00Z","interval":{"unit":"once","value":""}}`,
+			want: RunAt{StartTime: start, IntervalUnit: IntervalOnce},
+		},
+		{
+			name: "start time without zone",
+			json: `{"start_time":"2024-05-01 10:00:00","interval":{"unit":"once"}}`,
+			want: RunAt{StartTime: start, IntervalUnit: IntervalOnce},
+		},
+		{
+			name: "missing start time",
+			json: `{"tz":"UTC","interval":{"unit":"monthly","value":"1"}}`,
+			want: RunAt{TimeZone: "UTC", IntervalUnit: IntervalMonthly, IntervalValue: 1},
+		},
+		{
+			name:    "invalid interval value",
+			json:    `{"interval":{"unit":"daily","value":"often"}}`,
+			wantErr: true,
+		},
+		{
+			name:    "invalid start time",
+			json:    `{"start_time":"tomorrow","interval":{"unit":"once"}}`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := RunAt{}
+			err := json.Unmarshal([]byte(tt.json), &got)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("expected an error, got %+v", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
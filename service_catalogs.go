@@ -2,15 +2,43 @@ package manageiq
 
 import (
 	"encoding/json"
+	"net/url"
 )
 
 type ServiceCatalogs struct {
 	MangeIQListResource
+	Resources []ServiceCatalog `json:"resources"`
 }
 
-func (c *Client) GetServiceCatalogs() (*ServiceCatalogs, error) {
+type ServiceCatalog struct {
+	Href        string   `json:"href"`
+	ID          string   `json:"id"`
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	TenantID    string   `json:"tenant_id"`
+	Actions     []Action `json:"actions"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (s *ServiceCatalog) UnmarshalJSON(b []byte) error {
+	type alias ServiceCatalog
+	extra, err := unmarshalWithExtra(b, (*alias)(s))
+	if err != nil {
+		return err
+	}
+	s.Extra = extra
+	return nil
+}
+
+func (s ServiceCatalog) MarshalJSON() ([]byte, error) {
+	type alias ServiceCatalog
+	return marshalWithExtra(alias(s), s.Extra)
+}
+
+func (c *Client) GetServiceCatalogs(queries url.Values) (*ServiceCatalogs, error) {
 	builder := NewRequestBuilder(GET)
-	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/service_catalogs", nil, nil)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/service_catalogs", nil, queries)
 	if err != nil {
 		return nil, err
 	}
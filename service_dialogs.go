@@ -0,0 +1,445 @@
+package manageiq
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	DialogFieldTextBox         = "DialogFieldTextBox"
+	DialogFieldTextAreaBox     = "DialogFieldTextAreaBox"
+	DialogFieldCheckBox        = "DialogFieldCheckBox"
+	DialogFieldDropDownList    = "DialogFieldDropDownList"
+	DialogFieldRadioButton     = "DialogFieldRadioButton"
+	DialogFieldDateControl     = "DialogFieldDateControl"
+	DialogFieldDateTimeControl = "DialogFieldDateTimeControl"
+	DialogFieldTagControl      = "DialogFieldTagControl"
+)
+
+type ServiceDialogs struct {
+	MangeIQListResource
+	Resources []ServiceDialog `json:"resources"`
+}
+
+type ServiceDialog struct {
+	Href        string          `json:"href"`
+	ID          string          `json:"id"`
+	Label       string          `json:"label"`
+	Description string          `json:"description"`
+	Buttons     string          `json:"buttons"`
+	CreatedAt   time.Time       `json:"created_at"`
+	UpdatedAt   time.Time       `json:"updated_at"`
+	Content     []DialogContent `json:"content"`
+	Actions     []Action        `json:"actions"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (s *ServiceDialog) UnmarshalJSON(b []byte) error {
+	type alias ServiceDialog
+	extra, err := unmarshalWithExtra(b, (*alias)(s))
+	if err != nil {
+		return err
+	}
+	s.Extra = extra
+	return nil
+}
+
+func (s ServiceDialog) MarshalJSON() ([]byte, error) {
+	type alias ServiceDialog
+	return marshalWithExtra(alias(s), s.Extra)
+}
+
+type DialogContent struct {
+	ID          string      `json:"id"`
+	Label       string      `json:"label"`
+	Description string      `json:"description"`
+	DialogTabs  []DialogTab `json:"dialog_tabs"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (d *DialogContent) UnmarshalJSON(b []byte) error {
+	type alias DialogContent
+	extra, err := unmarshalWithExtra(b, (*alias)(d))
+	if err != nil {
+		return err
+	}
+	d.Extra = extra
+	return nil
+}
+
+func (d DialogContent) MarshalJSON() ([]byte, error) {
+	type alias DialogContent
+	return marshalWithExtra(alias(d), d.Extra)
+}
+
+type DialogTab struct {
+	ID           string        `json:"id"`
+	Label        string        `json:"label"`
+	Description  string        `json:"description"`
+	Position     int           `json:"position"`
+	DialogGroups []DialogGroup `json:"dialog_groups"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (d *DialogTab) UnmarshalJSON(b []byte) error {
+	type alias DialogTab
+	extra, err := unmarshalWithExtra(b, (*alias)(d))
+	if err != nil {
+		return err
+	}
+	d.Extra = extra
+	return nil
+}
+
+func (d DialogTab) MarshalJSON() ([]byte, error) {
+	type alias DialogTab
+	return marshalWithExtra(alias(d), d.Extra)
+}
+
+type DialogGroup struct {
+	ID           string        `json:"id"`
+	Label        string        `json:"label"`
+	Description  string        `json:"description"`
+	Position     int           `json:"position"`
+	DialogFields []DialogField `json:"dialog_fields"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (d *DialogGroup) UnmarshalJSON(b []byte) error {
+	type alias DialogGroup
+	extra, err := unmarshalWithExtra(b, (*alias)(d))
+	if err != nil {
+		return err
+	}
+	d.Extra = extra
+	return nil
+}
+
+func (d DialogGroup) MarshalJSON() ([]byte, error) {
+	type alias DialogGroup
+	return marshalWithExtra(alias(d), d.Extra)
+}
+
+type DialogField struct {
+	ID           string      `json:"id"`
+	Name         string      `json:"name"`
+	Label        string      `json:"label"`
+	Description  string      `json:"description"`
+	Type         string      `json:"type"`
+	DataType     string      `json:"data_type"`
+	Position     int         `json:"position"`
+	Required     bool        `json:"required"`
+	ReadOnly     bool        `json:"read_only"`
+	Visible      bool        `json:"visible"`
+	Dynamic      bool        `json:"dynamic"`
+	AutoRefresh  bool        `json:"auto_refresh"`
+	DefaultValue interface{} `json:"default_value"`
+	// Values holds the allowed values of the field, for drop downs and radio buttons
+	// these are [value, description] pairs.
+	Values        json.RawMessage        `json:"values"`
+	ValidatorType string                 `json:"validator_type"`
+	ValidatorRule string                 `json:"validator_rule"`
+	Options       map[string]interface{} `json:"options"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (d *DialogField) UnmarshalJSON(b []byte) error {
+	type alias DialogField
+	// Fields are visible unless stated otherwise
+	d.Visible = true
+	extra, err := unmarshalWithExtra(b, (*alias)(d))
+	if err != nil {
+		return err
+	}
+	d.Extra = extra
+	return nil
+}
+
+func (d DialogField) MarshalJSON() ([]byte, error) {
+	type alias DialogField
+	return marshalWithExtra(alias(d), d.Extra)
+}
+
+// AllowedValues returns the values the field can be set to, or nil if the field isn't restricted.
+func (f *DialogField) AllowedValues() []string {
+	var pairs [][]interface{}
+	if err := json.Unmarshal(f.Values, &pairs); err != nil {
+		return nil
+	}
+	var values []string
+	for _, pair := range pairs {
+		if len(pair) == 0 || pair[0] == nil {
+			continue
+		}
+		values = append(values, fmt.Sprint(pair[0]))
+	}
+	return values
+}
+
+// Fields returns all the fields of the dialog across its tabs and groups.
+func (d *ServiceDialog) Fields() []DialogField {
+	var fields []DialogField
+	for _, content := range d.Content {
+		for _, tab := range content.DialogTabs {
+			for _, group := range tab.DialogGroups {
+				fields = append(fields, group.DialogFields...)
+			}
+		}
+	}
+	return fields
+}
+
+// FieldError describes a dialog value which failed the validation.
+type FieldError struct {
+	Field   string
+	Message string
+}
+
+func (e FieldError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Field, e.Message)
+}
+
+// DialogValidationError holds all the field errors found while validating the dialog values.
+type DialogValidationError struct {
+	Errors []FieldError
+}
+
+func (e *DialogValidationError) Error() string {
+	msgs := make([]string, 0, len(e.Errors))
+	for _, fe := range e.Errors {
+		msgs = append(msgs, fe.Error())
+	}
+	return fmt.Sprintf("invalid dialog values: %s", strings.Join(msgs, "; "))
+}
+
+// Validate checks the dialog values against the required flags, types and allowed values of the
+// dialog fields, a *DialogValidationError is returned when any of the values is invalid.
+func (d *ServiceDialog) Validate(values map[string]interface{}) error {
+	var errs []FieldError
+	for _, field := range d.Fields() {
+		// Hidden fields aren't submitted by the UI, so they can't be required.
+		if field.ReadOnly || !field.Visible {
+			continue
+		}
+		value, ok := values[field.Name]
+		if !ok || isEmptyValue(value) {
+			if field.Required {
+				errs = append(errs, FieldError{Field: field.Name, Message: "value is required"})
+			}
+			continue
+		}
+		if msg := validateFieldValue(&field, value); msg != "" {
+			errs = append(errs, FieldError{Field: field.Name, Message: msg})
+		}
+	}
+	if len(errs) > 0 {
+		return &DialogValidationError{Errors: errs}
+	}
+	return nil
+}
+
+func validateFieldValue(field *DialogField, value interface{}) string {
+	switch field.Type {
+	case DialogFieldCheckBox:
+		switch v := value.(type) {
+		case bool:
+		case string:
+			if _, err := strconv.ParseBool(v); err != nil && v != "t" && v != "f" {
+				return fmt.Sprintf("'%s' is not a boolean", v)
+			}
+		default:
+			return fmt.Sprintf("expected a boolean, got %T", value)
+		}
+		return ""
+	case DialogFieldDateControl, DialogFieldDateTimeControl:
+		switch v := value.(type) {
+		case time.Time:
+		case string:
+			if _, ok := parseTime(v); !ok {
+				return fmt.Sprintf("'%s' is not a date", v)
+			}
+		default:
+			return fmt.Sprintf("expected a date, got %T", value)
+		}
+		return ""
+	case DialogFieldDropDownList, DialogFieldRadioButton:
+		// Values of dynamic fields are only known to the server, see RefreshDialogFields.
+		if field.Dynamic {
+			return ""
+		}
+		allowed := field.AllowedValues()
+		if allowed == nil {
+			return ""
+		}
+		selected := []interface{}{value}
+		if multi, ok := value.([]interface{}); ok {
+			selected = multi
+		} else if multi, ok := value.([]string); ok {
+			selected = nil
+			for _, v := range multi {
+				selected = append(selected, v)
+			}
+		}
+		for _, v := range selected {
+			if !containsString(allowed, fmt.Sprint(v)) {
+				return fmt.Sprintf("'%v' is not one of the allowed values %v", v, allowed)
+			}
+		}
+		return ""
+	}
+
+	if field.DataType == "integer" {
+		switch v := value.(type) {
+		case int, int32, int64:
+		case float64:
+			if v != float64(int64(v)) {
+				return fmt.Sprintf("'%v' is not an integer", v)
+			}
+		case string:
+			if _, err := strconv.ParseInt(v, 10, 64); err != nil {
+				return fmt.Sprintf("'%s' is not an integer", v)
+			}
+		default:
+			return fmt.Sprintf("expected an integer, got %T", value)
+		}
+	}
+
+	if field.ValidatorType == "regex" && field.ValidatorRule != "" {
+		re, err := regexp.Compile(field.ValidatorRule)
+		if err != nil {
+			return fmt.Sprintf("invalid validator rule %s: %s", field.ValidatorRule, err.Error())
+		}
+		if !re.MatchString(fmt.Sprint(value)) {
+			return fmt.Sprintf("'%v' doesn't match %s", value, field.ValidatorRule)
+		}
+	}
+	return ""
+}
+
+// isEmptyValue returns true for the values the UI submits for fields left empty, including the
+// empty selections of the multi-select fields.
+func isEmptyValue(value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case []string:
+		return len(v) == 0
+	case []interface{}:
+		return len(v) == 0
+	}
+	return false
+}
+
+func containsString(values []string, s string) bool {
+	for _, v := range values {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *Client) ListServiceDialogs(queries url.Values) (*ServiceDialogs, error) {
+	builder := NewRequestBuilder(GET)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/service_dialogs", nil, queries)
+	if err != nil {
+		return nil, err
+	}
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	s := &ServiceDialogs{}
+	if err := json.Unmarshal(resp.RawResult, &s); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (c *Client) GetServiceDialog(id string, queries url.Values) (*ServiceDialog, error) {
+	builder := NewRequestBuilder(GET)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/service_dialogs/"+id, nil, withList(queries, "attributes", "content"))
+	if err != nil {
+		return nil, err
+	}
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	s := &ServiceDialog{}
+	if err := json.Unmarshal(resp.RawResult, &s); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// RefreshDialogFieldsParams is the resource of the refresh_dialog_fields action.
+type RefreshDialogFieldsParams struct {
+	// Current values of the dialog fields
+	DialogFields map[string]interface{} `json:"dialog_fields"`
+	// Names of the fields to be refreshed
+	Fields           []string `json:"fields"`
+	ResourceActionID string   `json:"resource_action_id,omitempty"`
+	TargetID         string   `json:"target_id,omitempty"`
+	TargetType       string   `json:"target_type,omitempty"`
+}
+
+type refreshDialogFieldsResult struct {
+	Success bool                   `json:"success"`
+	Message string                 `json:"message"`
+	Result  map[string]DialogField `json:"result"`
+}
+
+// RefreshDialogFields re-evaluates the dynamic fields of the dialog and returns them keyed by the field name.
+func (c *Client) RefreshDialogFields(ctx context.Context, id string, params *RefreshDialogFieldsParams) (map[string]DialogField, error) {
+	builder := NewRequestBuilder(POST).WithContext(ctx)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/service_dialogs/"+id, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := builder.SetBodyContentJSON(&actionBody{Action: "refresh_dialog_fields", Resource: params}); err != nil {
+		return nil, err
+	}
+
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	r := &refreshDialogFieldsResult{}
+	if err := json.Unmarshal(resp.RawResult, &r); err != nil {
+		return nil, err
+	}
+	if !r.Success {
+		return nil, fmt.Errorf("failed to refresh dialog fields: %s", r.Message)
+	}
+	return r.Result, nil
+}
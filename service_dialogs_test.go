@@ -0,0 +1,118 @@
+package manageiq
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+const testServiceDialog = `{
+	"id": "1",
+	"label": "Deploy",
+	"content": [{
+		"dialog_tabs": [{
+			"dialog_groups": [{
+				"dialog_fields": [
+					{"name": "vm_name", "type": "DialogFieldTextBox", "required": true, "validator_type": "regex", "validator_rule": "^[a-z]+$"},
+					{"name": "cpus", "type": "DialogFieldTextBox", "data_type": "integer"},
+					{"name": "size", "type": "DialogFieldDropDownList", "values": [["small", "Small"], ["large", "Large"]]},
+					{"name": "backup", "type": "DialogFieldCheckBox"},
+					{"name": "retire_on", "type": "DialogFieldDateControl"},
+					{"name": "start_at", "type": "DialogFieldDateTimeControl"},
+					{"name": "internal_id", "type": "DialogFieldTextBox", "required": true, "visible": false},
+					{"name": "owner", "type": "DialogFieldTextBox", "required": true, "read_only": true},
+					{"name": "networks", "type": "DialogFieldDropDownList", "required": true, "dynamic": true, "options": {"force_multi_value": true}},
+					{"name": "code", "type": "DialogFieldTextBox", "validator_type": "regex", "validator_rule": "[a-z"}
+				]
+			}]
+		}]
+	}]
+}`
+
+func TestServiceDialogValidate(t *testing.T) {
+	dialog := &ServiceDialog{}
+	if err := json.Unmarshal([]byte(testServiceDialog), dialog); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		values map[string]interface{}
+		want   []FieldError
+	}{
+		{
+			name: "valid",
+			values: map[string]interface{}{
+				"vm_name":   "web",
+				"cpus":      "2",
+				"size":      "large",
+				"backup":    "t",
+				"retire_on": "2024-05-01",
+				"start_at":  "2024-05-01T10:00:00Z",
+				"networks":  []string{"public"},
+			},
+		},
+		{
+			name: "typed values",
+			values: map[string]interface{}{
+				"vm_name":   "web",
+				"cpus":      float64(4),
+				"size":      []string{"small"},
+				"backup":    true,
+				"retire_on": time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC),
+				"start_at":  "2024-05-01 10:00:00 UTC",
+				"networks":  []interface{}{"public", "private"},
+			},
+		},
+		{
+			name:   "missing required value",
+			values: map[string]interface{}{"networks": []string{}},
+			want: []FieldError{
+				{Field: "vm_name", Message: "value is required"},
+				{Field: "networks", Message: "value is required"},
+			},
+		},
+		{
+			name: "invalid values",
+			values: map[string]interface{}{
+				"vm_name":   "Web1",
+				"cpus":      "two",
+				"size":      "huge",
+				"backup":    "maybe",
+				"retire_on": "tomorrow",
+				"start_at":  42,
+				"networks":  []interface{}{"public"},
+				"code":      "abc",
+			},
+			want: []FieldError{
+				{Field: "vm_name", Message: "'Web1' doesn't match ^[a-z]+$"},
+				{Field: "cpus", Message: "'two' is not an integer"},
+				{Field: "size", Message: "'huge' is not one of the allowed values [small large]"},
+				{Field: "backup", Message: "'maybe' is not a boolean"},
+				{Field: "retire_on", Message: "'tomorrow' is not a date"},
+				{Field: "start_at", Message: "expected a date, got int"},
+				{Field: "code", Message: "invalid validator rule [a-z: error parsing regexp: missing closing ]: `[a-z`"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := dialog.Validate(tt.values)
+			if tt.want == nil {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			var validationErr *DialogValidationError
+			if !errors.As(err, &validationErr) {
+				t.Fatalf("expected a *DialogValidationError, got %v", err)
+			}
+			if !reflect.DeepEqual(validationErr.Errors, tt.want) {
+				t.Errorf("expected %v, got %v", tt.want, validationErr.Errors)
+			}
+		})
+	}
+}
@@ -1,38 +1,152 @@
 package manageiq
 
 import (
+	"context"
 	"encoding/json"
 	"net/url"
+	"strings"
+	"time"
 )
 
+const retireDateFormat = "2006-01-02"
+
+// LifecycleState is the provisioning state of a service.
+type LifecycleState string
+
+const (
+	LifecycleProvisioning        LifecycleState = "provisioning"
+	LifecycleProvisioned         LifecycleState = "provisioned"
+	LifecycleErrorInProvisioning LifecycleState = "error_in_provisioning"
+	LifecycleUnprovisioned       LifecycleState = "unprovisioned"
+)
+
+func (l LifecycleState) String() string {
+	return string(l)
+}
+
+func (l *LifecycleState) UnmarshalJSON(b []byte) error {
+	var s *string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	if s == nil {
+		*l = ""
+		return nil
+	}
+	*l = LifecycleState(strings.ToLower(*s))
+	return nil
+}
+
+// PowerState is the power state of a VM as normalized by ManageIQ from the provider specific state.
+type PowerState string
+
+const (
+	PowerStateOn         PowerState = "on"
+	PowerStateOff        PowerState = "off"
+	PowerStateSuspended  PowerState = "suspended"
+	PowerStateTerminated PowerState = "terminated"
+	PowerStateArchived   PowerState = "archived"
+	PowerStateOrphaned   PowerState = "orphaned"
+	PowerStateNever      PowerState = "never"
+	PowerStateUnknown    PowerState = "unknown"
+)
+
+func (p PowerState) String() string {
+	return string(p)
+}
+
+func (p *PowerState) UnmarshalJSON(b []byte) error {
+	var s *string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	if s == nil {
+		*p = ""
+		return nil
+	}
+	*p = PowerState(strings.ToLower(*s))
+	return nil
+}
+
 type Services struct {
 	MangeIQListResource
+	Resources []Service `json:"resources"`
 }
 
 type Service struct {
 	MangeIQListResource
-	CreatedAt      string `json:"created_at"`
-	UpdatedAt      string `json:"updated_at"`
-	Retired        bool   `json:"retired"`
-	RetiresOn      string `json:"retires_on"`
-	LifecycleState string `json:"lifecycle_state"`
-	VMs            []VM   `json:"vms"`
+	Href           string         `json:"href,omitempty"`
+	Description    string         `json:"description,omitempty"`
+	CreatedAt      time.Time      `json:"created_at"`
+	UpdatedAt      time.Time      `json:"updated_at"`
+	Retired        bool           `json:"retired"`
+	RetiresOn      time.Time      `json:"retires_on"`
+	LifecycleState LifecycleState `json:"lifecycle_state,omitempty"`
+	// Relationships and virtual attributes, only populated when requested with WithAttributes
+	VMs              []VM              `json:"vms,omitempty"`
+	Tags             []Tag             `json:"tags,omitempty"`
+	CustomAttributes []CustomAttribute `json:"custom_attributes,omitempty"`
+	Picture          *Picture          `json:"picture,omitempty"`
+	ParentService    *Service          `json:"parent_service,omitempty"`
+	ChildServices    []Service         `json:"direct_service_children,omitempty"`
+
+	// Attributes requested which aren't mapped by the fields above
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (s *Service) UnmarshalJSON(b []byte) error {
+	type alias Service
+	extra, err := unmarshalWithExtra(b, (*alias)(s))
+	if err != nil {
+		return err
+	}
+	s.Extra = extra
+	return nil
+}
+
+func (s Service) MarshalJSON() ([]byte, error) {
+	type alias Service
+	return marshalWithExtra(alias(s), s.Extra)
 }
 
 type VM struct {
-	CreatedOn    string   `json:"created_on"`
-	Description  string   `json:"description"`
-	EMSCreatedOn string   `json:"ems_created_on"`
-	EMSID        string   `json:"ems_id"`
-	EMSRef       string   `json:"ems_ref"`
-	ID           string   `json:"id"`
-	IPAddresses  []string `json:"ipaddresses"`
-	Name         string   `json:"name"`
-	Vendor       string   `json:"vendor"`
+	CreatedOn    time.Time `json:"created_on"`
+	Description  string    `json:"description"`
+	EMSCreatedOn time.Time `json:"ems_created_on"`
+	EMSID        string    `json:"ems_id"`
+	EMSRef       string    `json:"ems_ref"`
+	ID           string    `json:"id"`
+	IPAddresses  []string  `json:"ipaddresses"`
+	Name         string    `json:"name"`
+	Vendor       string    `json:"vendor"`
 	// ID for the vm in the powervs(backend)
 	UIDEMS string `json:"uid_ems"`
-	// Power state of the vm
+	// Power state of the vm as returned by the provider
 	RawPowerState string `json:"raw_power_state"`
+	// Power state of the vm normalized by ManageIQ
+	PowerState PowerState `json:"power_state,omitempty"`
+
+	// Relationships and virtual attributes, only populated when requested with WithAttributes
+	Tags             []Tag             `json:"tags,omitempty"`
+	CustomAttributes []CustomAttribute `json:"custom_attributes,omitempty"`
+
+	// Attributes requested which aren't mapped by the fields above
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (v *VM) UnmarshalJSON(b []byte) error {
+	type alias VM
+	extra, err := unmarshalWithExtra(b, (*alias)(v))
+	if err != nil {
+		return err
+	}
+	v.Extra = extra
+	return nil
+}
+
+func (v VM) MarshalJSON() ([]byte, error) {
+	type alias VM
+	return marshalWithExtra(alias(v), v.Extra)
 }
 
 func (c *Client) ListServices(queries url.Values) (*Services, error) {
@@ -105,3 +219,124 @@ func (c *Client) UpdateService(id string, body interface{}) (*Service, error) {
 	}
 	return s, nil
 }
+
+// ServiceUpdate is the body of UpdateService editing the attributes of the service, the attributes
+// left empty aren't changed.
+type ServiceUpdate struct {
+	Name        string
+	Description string
+	// Retirement date of the service, a zero date clears it
+	RetiresOn *time.Time
+	// Other attributes to edit keyed by their name
+	Attributes map[string]interface{}
+}
+
+func (u ServiceUpdate) MarshalJSON() ([]byte, error) {
+	resource := map[string]interface{}{}
+	for k, v := range u.Attributes {
+		resource[k] = v
+	}
+	if u.Name != "" {
+		resource["name"] = u.Name
+	}
+	if u.Description != "" {
+		resource["description"] = u.Description
+	}
+	if u.RetiresOn != nil {
+		resource["retires_on"] = nil
+		if !u.RetiresOn.IsZero() {
+			resource["retires_on"] = u.RetiresOn.Format(retireDateFormat)
+		}
+	}
+	return json.Marshal(&actionBody{Action: "edit", Resource: resource})
+}
+
+type RetireOptions struct {
+	// Date on which the service is retired
+	Date time.Time
+	// Number of days before the retirement date to warn the owner
+	WarnDays int
+}
+
+type retireBody struct {
+	Date string `json:"date"`
+	Warn int    `json:"warn,omitempty"`
+}
+
+type OwnershipRef struct {
+	ID          string `json:"id,omitempty"`
+	Href        string `json:"href,omitempty"`
+	UserID      string `json:"userid,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+type Ownership struct {
+	Owner *OwnershipRef `json:"owner,omitempty"`
+	Group *OwnershipRef `json:"group,omitempty"`
+}
+
+type resourceRef struct {
+	Resource ResourceRef `json:"resource"`
+}
+
+type ResourceRef struct {
+	Href string `json:"href"`
+}
+
+func (c *Client) serviceAction(ctx context.Context, id, action string, data interface{}) (*ActionResult, error) {
+	return c.doAction(ctx, POST, c.Authenticator.GetBaseURL(), "/services/"+id, action, data)
+}
+
+// RetireService retires the service immediately when opts is nil, otherwise schedules the retirement on opts.Date.
+func (c *Client) RetireService(ctx context.Context, id string, opts *RetireOptions) (*ActionResult, error) {
+	if opts == nil || opts.Date.IsZero() {
+		return c.serviceAction(ctx, id, "retire", nil)
+	}
+	return c.serviceAction(ctx, id, "retire", &retireBody{Date: opts.Date.Format(retireDateFormat), Warn: opts.WarnDays})
+}
+
+func (c *Client) RequestRetireService(ctx context.Context, id string) (*ActionResult, error) {
+	return c.serviceAction(ctx, id, "request_retire", nil)
+}
+
+// ReconfigureService reconfigures the service with the dialog values of its reconfigure dialog.
+func (c *Client) ReconfigureService(ctx context.Context, id string, dialogValues map[string]interface{}) (*ActionResult, error) {
+	return c.serviceAction(ctx, id, "reconfigure", dialogValues)
+}
+
+func (c *Client) StartService(ctx context.Context, id string) (*ActionResult, error) {
+	return c.serviceAction(ctx, id, "start", nil)
+}
+
+func (c *Client) StopService(ctx context.Context, id string) (*ActionResult, error) {
+	return c.serviceAction(ctx, id, "stop", nil)
+}
+
+func (c *Client) SuspendService(ctx context.Context, id string) (*ActionResult, error) {
+	return c.serviceAction(ctx, id, "suspend", nil)
+}
+
+func (c *Client) SetOwnership(ctx context.Context, id string, ownership Ownership) (*ActionResult, error) {
+	return c.serviceAction(ctx, id, "set_ownership", ownership)
+}
+
+func (c *Client) ListChildServices(id string) ([]Service, error) {
+	s, err := c.GetService(id, url.Values{"attributes": []string{"direct_service_children"}})
+	if err != nil {
+		return nil, err
+	}
+	return s.ChildServices, nil
+}
+
+// AddResource adds the resource (e.g. a vm or a child service) referenced by href to the service.
+func (c *Client) AddResource(ctx context.Context, id string, href string) (*ActionResult, error) {
+	return c.serviceAction(ctx, id, "add_resource", &resourceRef{Resource: ResourceRef{Href: href}})
+}
+
+func (c *Client) RemoveResource(ctx context.Context, id string, href string) (*ActionResult, error) {
+	return c.serviceAction(ctx, id, "remove_resource", &resourceRef{Resource: ResourceRef{Href: href}})
+}
+
+func (c *Client) RemoveAllResources(ctx context.Context, id string) (*ActionResult, error) {
+	return c.serviceAction(ctx, id, "remove_all_resources", nil)
+}
@@ -0,0 +1,97 @@
+package manageiq
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestServiceStates(t *testing.T) {
+	tests := []struct {
+		input          string
+		wantLifecycle  LifecycleState
+		wantPowerState PowerState
+		wantRawState   string
+	}{
+		{
+			input:          `{"lifecycle_state": "provisioned", "vms": [{"raw_power_state": "ACTIVE", "power_state": "on"}]}`,
+			wantLifecycle:  LifecycleProvisioned,
+			wantPowerState: PowerStateOn,
+			wantRawState:   "ACTIVE",
+		},
+		{
+			input:          `{"lifecycle_state": "Error_In_Provisioning", "vms": [{"raw_power_state": "poweredOff", "power_state": "off"}]}`,
+			wantLifecycle:  LifecycleErrorInProvisioning,
+			wantPowerState: PowerStateOff,
+			wantRawState:   "poweredOff",
+		},
+		{
+			input: `{"lifecycle_state": null, "vms": [{"raw_power_state": null, "power_state": null}]}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			s := &Service{}
+			if err := json.Unmarshal([]byte(tt.input), s); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if s.LifecycleState != tt.wantLifecycle {
+				t.Errorf("expected lifecycle state %q, got %q", tt.wantLifecycle, s.LifecycleState)
+			}
+			if s.VMs[0].PowerState != tt.wantPowerState {
+				t.Errorf("expected power state %q, got %q", tt.wantPowerState, s.VMs[0].PowerState)
+			}
+			if s.VMs[0].RawPowerState != tt.wantRawState {
+				t.Errorf("expected raw power state %q, got %q", tt.wantRawState, s.VMs[0].RawPowerState)
+			}
+		})
+	}
+
+	if err := json.Unmarshal([]byte(`{"lifecycle_state": {}}`), &Service{}); err == nil {
+		t.Errorf("expected an error for a non string lifecycle state")
+	}
+	if got := PowerStateSuspended.String(); got != "suspended" {
+		t.Errorf("expected suspended, got %s", got)
+	}
+}
+
+func TestServiceUpdateMarshal(t *testing.T) {
+	retiresOn := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name   string
+		update ServiceUpdate
+		want   string
+	}{
+		{
+			name:   "unchanged retirement",
+			update: ServiceUpdate{Name: "web"},
+			want:   `{"action":"edit","resource":{"name":"web"}}`,
+		},
+		{
+			name:   "set retirement",
+			update: ServiceUpdate{RetiresOn: &retiresOn},
+			want:   `{"action":"edit","resource":{"retires_on":"2024-06-01"}}`,
+		},
+		{
+			name:   "clear retirement",
+			update: ServiceUpdate{Description: "web tier", RetiresOn: &time.Time{}},
+			want:   `{"action":"edit","resource":{"description":"web tier","retires_on":null}}`,
+		},
+		{
+			name:   "other attributes",
+			update: ServiceUpdate{Attributes: map[string]interface{}{"service_template_id": "4"}},
+			want:   `{"action":"edit","resource":{"service_template_id":"4"}}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := json.Marshal(tt.update)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("expected %s, got %s", tt.want, got)
+			}
+		})
+	}
+}
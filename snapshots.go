@@ -0,0 +1,114 @@
+package manageiq
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"time"
+)
+
+type Snapshots struct {
+	MangeIQListResource
+	Resources []Snapshot `json:"resources"`
+}
+
+type Snapshot struct {
+	Href           string    `json:"href"`
+	ID             string    `json:"id"`
+	UID            string    `json:"uid"`
+	ParentUID      string    `json:"parent_uid"`
+	ParentID       string    `json:"parent_id"`
+	Name           string    `json:"name"`
+	Description    string    `json:"description"`
+	Current        int       `json:"current"`
+	TotalSize      int64     `json:"total_size"`
+	Filename       string    `json:"filename"`
+	EMSRef         string    `json:"ems_ref"`
+	VMOrTemplateID string    `json:"vm_or_template_id"`
+	CreateTime     time.Time `json:"create_time"`
+	CreatedOn      time.Time `json:"created_on"`
+	UpdatedOn      time.Time `json:"updated_on"`
+	Actions        []Action  `json:"actions"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (s *Snapshot) UnmarshalJSON(b []byte) error {
+	type alias Snapshot
+	extra, err := unmarshalWithExtra(b, (*alias)(s))
+	if err != nil {
+		return err
+	}
+	s.Extra = extra
+	return nil
+}
+
+func (s Snapshot) MarshalJSON() ([]byte, error) {
+	type alias Snapshot
+	return marshalWithExtra(alias(s), s.Extra)
+}
+
+type SnapshotParams struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	// Include the memory of the vm in the snapshot
+	Memory bool `json:"memory"`
+}
+
+func (c *Client) ListSnapshots(vmID string, queries url.Values) (*Snapshots, error) {
+	builder := NewRequestBuilder(GET)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/vms/"+vmID+"/snapshots", nil, queries)
+	if err != nil {
+		return nil, err
+	}
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	s := &Snapshots{}
+	if err := json.Unmarshal(resp.RawResult, &s); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (c *Client) GetSnapshot(vmID, id string, queries url.Values) (*Snapshot, error) {
+	builder := NewRequestBuilder(GET)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/vms/"+vmID+"/snapshots/"+id, nil, queries)
+	if err != nil {
+		return nil, err
+	}
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	s := &Snapshot{}
+	if err := json.Unmarshal(resp.RawResult, &s); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// CreateSnapshot queues the snapshot of the vm, the snapshot is taken by the task returned.
+func (c *Client) CreateSnapshot(ctx context.Context, vmID string, params *SnapshotParams) (*ActionResult, error) {
+	return c.doCollectionAction(ctx, "/vms/"+vmID+"/snapshots", "create", params)
+}
+
+func (c *Client) DeleteSnapshot(ctx context.Context, vmID, id string) (*ActionResult, error) {
+	return c.doAction(ctx, POST, c.Authenticator.GetBaseURL(), "/vms/"+vmID+"/snapshots/"+id, "delete", nil)
+}
+
+// RevertSnapshot reverts the vm to the snapshot, the vm is reverted by the task returned.
+func (c *Client) RevertSnapshot(ctx context.Context, vmID, id string) (*ActionResult, error) {
+	return c.doAction(ctx, POST, c.Authenticator.GetBaseURL(), "/vms/"+vmID+"/snapshots/"+id, "revert", nil)
+}
@@ -0,0 +1,132 @@
+package manageiq
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+const (
+	TaskStateFinished = "Finished"
+
+	TaskStatusOk    = "Ok"
+	TaskStatusWarn  = "Warn"
+	TaskStatusError = "Error"
+)
+
+type Tasks struct {
+	MangeIQListResource
+	Resources []Task `json:"resources"`
+}
+
+type Task struct {
+	Href        string      `json:"href"`
+	ID          string      `json:"id"`
+	Name        string      `json:"name"`
+	State       string      `json:"state"`
+	Status      string      `json:"status"`
+	Message     string      `json:"message"`
+	UserID      string      `json:"userid"`
+	PctComplete int         `json:"pct_complete"`
+	ContextData interface{} `json:"context_data"`
+	Results     interface{} `json:"results"`
+	CreatedOn   time.Time   `json:"created_on"`
+	UpdatedOn   time.Time   `json:"updated_on"`
+	StartedOn   time.Time   `json:"started_on"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (t *Task) UnmarshalJSON(b []byte) error {
+	type alias Task
+	extra, err := unmarshalWithExtra(b, (*alias)(t))
+	if err != nil {
+		return err
+	}
+	t.Extra = extra
+	return nil
+}
+
+func (t Task) MarshalJSON() ([]byte, error) {
+	type alias Task
+	return marshalWithExtra(alias(t), t.Extra)
+}
+
+func (c *Client) ListTasks(queries url.Values) (*Tasks, error) {
+	builder := NewRequestBuilder(GET)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/tasks", nil, queries)
+	if err != nil {
+		return nil, err
+	}
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	t := &Tasks{}
+	if err := json.Unmarshal(resp.RawResult, &t); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+func (c *Client) GetTask(id string, queries url.Values) (*Task, error) {
+	return c.getTask(context.Background(), id, queries)
+}
+
+func (c *Client) getTask(ctx context.Context, id string, queries url.Values) (*Task, error) {
+	builder := NewRequestBuilder(GET).WithContext(ctx)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/tasks/"+id, nil, queries)
+	if err != nil {
+		return nil, err
+	}
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	t := &Task{}
+	if err := json.Unmarshal(resp.RawResult, &t); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// WaitForTask polls the task every interval until it is finished and returns its final state,
+// an error is returned if the task finished with the Error status. The default interval is used if
+// interval isn't positive.
+func (c *Client) WaitForTask(ctx context.Context, id string, interval time.Duration) (*Task, error) {
+	if interval <= 0 {
+		interval = defaultWaitInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		task, err := c.getTask(ctx, id, nil)
+		if err != nil {
+			return nil, err
+		}
+		if task.State == TaskStateFinished {
+			if task.Status == TaskStatusError {
+				return task, fmt.Errorf("task %s failed: %s", id, task.Message)
+			}
+			return task, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
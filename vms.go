@@ -0,0 +1,33 @@
+package manageiq
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+)
+
+func (c *Client) GetVM(id string, queries url.Values) (*VM, error) {
+	return c.getVM(context.Background(), id, queries)
+}
+
+func (c *Client) getVM(ctx context.Context, id string, queries url.Values) (*VM, error) {
+	builder := NewRequestBuilder(GET).WithContext(ctx)
+	_, err := builder.ResolveRequestURL(c.Authenticator.GetBaseURL(), "/vms/"+id, nil, queries)
+	if err != nil {
+		return nil, err
+	}
+	req, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	v := &VM{}
+	if err := json.Unmarshal(resp.RawResult, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}